@@ -0,0 +1,141 @@
+package helpscout
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// MaxAttachmentSize ..
+const MaxAttachmentSize = 10 << 20
+
+// Attachment ..
+type Attachment struct {
+	ID       int    `json:"id"`
+	FileName string `json:"filename"`
+	MimeType string `json:"mimeType"`
+	Size     int    `json:"size"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+	URL      string `json:"url"`
+	DataURL  string `json:"dataUrl"`
+}
+
+// UnmarshalJSON fills URL and DataURL from the web and data links of the
+// attachment, the API doesn't send them as plain fields
+func (a *Attachment) UnmarshalJSON(data []byte) error {
+	type plain Attachment
+	var aux struct {
+		plain
+		Links struct {
+			Web struct {
+				Href string `json:"href"`
+			} `json:"web"`
+			Data struct {
+				Href string `json:"href"`
+			} `json:"data"`
+		} `json:"_links"`
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	*a = Attachment(aux.plain)
+	if a.URL == "" {
+		a.URL = aux.Links.Web.Href
+	}
+
+	if a.DataURL == "" {
+		a.DataURL = aux.Links.Data.Href
+	}
+
+	return nil
+}
+
+// NewAttachmentFromReader reads the attachment data from r, detecting the
+// mime type if it's empty and rejecting data over MaxAttachmentSize
+func NewAttachmentFromReader(fileName string, mimeType string, r io.Reader) (NewAttachment, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r, MaxAttachmentSize+1))
+	if err != nil {
+		return NewAttachment{}, errors.Wrap(err, "Unable to read attachment data")
+	}
+
+	if len(data) > MaxAttachmentSize {
+		return NewAttachment{}, errors.Wrapf(ErrorValidation, "Attachment %s exceeds %d bytes", fileName, MaxAttachmentSize)
+	}
+
+	if mimeType == "" {
+		mimeType = mime.TypeByExtension(filepath.Ext(fileName))
+	}
+
+	if mimeType == "" {
+		mimeType = http.DetectContentType(data)
+	}
+
+	return NewAttachment{
+		FileName: fileName,
+		MimeType: mimeType,
+		Data:     data,
+	}, nil
+}
+
+// ListThreadAttachments ..
+func (c *Client) ListThreadAttachments(ctx context.Context, conversationID int, threadID int) ([]Attachment, error) {
+	threads := c.IterateThreads(conversationID)
+	for threads.Next(ctx) {
+		if thread := threads.Value(); thread.ID == threadID {
+			return thread.Embedded.Attachments, nil
+		}
+	}
+
+	if err := threads.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, errors.Wrapf(ErrorNotFound, "Thread %d not found in conversation %d", threadID, conversationID)
+}
+
+// DownloadAttachment ..
+func (c *Client) DownloadAttachment(ctx context.Context, conversationID int, attachmentID int) (io.Reader, error) {
+	var respData struct {
+		Data string `json:"data"`
+	}
+
+	resource := fmt.Sprintf("/conversations/%d/attachments/%d/data", conversationID, attachmentID)
+	if err := c.doAPICall(ctx, http.MethodGet, resource, nil, nil, &respData); err != nil {
+		return nil, err
+	}
+
+	return base64.NewDecoder(base64.StdEncoding, bytes.NewBufferString(respData.Data)), nil
+}
+
+// UploadAttachment ..
+func (c *Client) UploadAttachment(ctx context.Context, conversationID int, threadID int,
+	fileName string, mimeType string, r io.Reader) (int, error) {
+
+	attachment, err := NewAttachmentFromReader(fileName, mimeType, r)
+	if err != nil {
+		return 0, err
+	}
+
+	resource := fmt.Sprintf("/conversations/%d/threads/%d/attachments", conversationID, threadID)
+
+	return c.createResource(ctx, resource, &attachment)
+}
+
+// DeleteAttachment ..
+func (c *Client) DeleteAttachment(ctx context.Context, conversationID int, attachmentID int) error {
+	resource := fmt.Sprintf("/conversations/%d/attachments/%d", conversationID, attachmentID)
+
+	return c.doAPICall(ctx, http.MethodDelete, resource, nil, nil, nil)
+}
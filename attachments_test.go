@@ -0,0 +1,49 @@
+package helpscout
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestThreadAttachmentLinks(t *testing.T) {
+	payload := `{
+		"id": 2198262392,
+		"type": "customer",
+		"_embedded": {
+			"attachments": [{
+				"id": 12391,
+				"filename": "photo.jpg",
+				"mimeType": "image/jpeg",
+				"size": 22,
+				"width": 160,
+				"height": 160,
+				"_links": {
+					"data": {"href": "https://api.helpscout.net/v2/conversations/10/attachments/12391/data"},
+					"web": {"href": "https://secure.helpscout.net/file/12391/photo.jpg"}
+				}
+			}]
+		}
+	}`
+
+	var thread Thread
+	if err := json.Unmarshal([]byte(payload), &thread); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(thread.Embedded.Attachments) != 1 {
+		t.Fatalf("expected one attachment, got %d", len(thread.Embedded.Attachments))
+	}
+
+	a := thread.Embedded.Attachments[0]
+	if a.ID != 12391 || a.FileName != "photo.jpg" || a.MimeType != "image/jpeg" || a.Size != 22 {
+		t.Fatalf("unexpected attachment %+v", a)
+	}
+
+	if a.URL != "https://secure.helpscout.net/file/12391/photo.jpg" {
+		t.Fatalf("unexpected web url %q", a.URL)
+	}
+
+	if a.DataURL != "https://api.helpscout.net/v2/conversations/10/attachments/12391/data" {
+		t.Fatalf("unexpected data url %q", a.DataURL)
+	}
+}
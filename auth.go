@@ -1,7 +1,9 @@
 package helpscout
 
 import (
+	"context"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -9,79 +11,330 @@ import (
 
 const helpscoutAuthEndpoint = "https://api.helpscout.net/v2/oauth2/token"
 
+const helpscoutAuthorizeEndpoint = "https://secure.helpscout.net/authentication/authorizeClientApplication"
+
+const (
+	grantTypeClientCredentials = "client_credentials"
+	grantTypeAuthorizationCode = "authorization_code"
+	grantTypeRefreshToken      = "refresh_token"
+)
+
+// tokenExpiryDelta is how long before its expiration a token is refreshed
+const tokenExpiryDelta = 10 * time.Minute
+
+// Token ..
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Expiry       time.Time `json:"expiry"`
+}
+
+// Valid reports whether t can be used for at least tokenExpiryDelta more,
+// a zero Expiry never expires
+func (t *Token) Valid() bool {
+	return t.validFor(tokenExpiryDelta)
+}
+
+// validFor ..
+func (t *Token) validFor(d time.Duration) bool {
+	return t != nil && t.AccessToken != "" && (t.Expiry.IsZero() || t.Expiry.After(time.Now().Add(d)))
+}
+
+// TokenSource ..
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
 type authReqData struct {
 	ClientID     string `json:"client_id"`
 	ClientSecret string `json:"client_secret"`
 	GrantType    string `json:"grant_type"`
+	Code         string `json:"code,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+}
+
+// tokenCall is an in-flight token refresh other callers wait for
+type tokenCall struct {
+	done chan struct{}
+	err  error
 }
 
 type auth struct {
-	httpClient      *httpClient
-	token           string
-	tokenExpireTime time.Time
-	appID           string
-	appKey          string
+	httpClient  *httpClient
+	retryPolicy RetryPolicy
+	authURL     string
+	appID       string
+	appKey      string
+	source      TokenSource
+	store       TokenStore
+
+	mu           sync.Mutex
+	token        *Token
+	staleToken   string
+	refreshToken string
+	refreshing   *tokenCall
 }
 
-func newAuth(httpClient *httpClient, appID string, appKey string) *auth {
+func newAuth(httpClient *httpClient, retryPolicy RetryPolicy, authURL string,
+	appID string, appKey string) *auth {
+
 	return &auth{
-		httpClient:      httpClient,
-		appID:           appID,
-		appKey:          appKey,
-		token:           "",
-		tokenExpireTime: time.Time{},
+		httpClient:  httpClient,
+		retryPolicy: retryPolicy,
+		authURL:     authURL,
+		appID:       appID,
+		appKey:      appKey,
+	}
+}
+
+// Token ..
+func (a *auth) Token(ctx context.Context) (*Token, error) {
+	return a.getToken(ctx, false)
+}
+
+// expiryDelta is how long before its expiration the cached token is
+// replaced. Tokens of a TokenSource are used until they expire, the source
+// decides when to renew them
+func (a *auth) expiryDelta() time.Duration {
+	if a.source != nil {
+		return 0
 	}
+
+	return tokenExpiryDelta
 }
 
-func (a *auth) getToken(forceUpdate bool) (string, error) {
+// setToken ..
+func (a *auth) setToken(token *Token) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 
-	/* token exists and still valid */
-	if !forceUpdate && a.token != "" && a.tokenExpireTime.After(time.Now().Add((10 * time.Minute))) {
-		return a.token, nil
+	a.token = token
+	if token.RefreshToken != "" {
+		a.refreshToken = token.RefreshToken
 	}
+}
 
-	reqData := authReqData{
+// exchangeCode ..
+func (a *auth) exchangeCode(ctx context.Context, code string) (*Token, error) {
+	token, err := a.requestToken(ctx, &authReqData{
 		ClientID:     a.appID,
 		ClientSecret: a.appKey,
-		GrantType:    "client_credentials",
+		GrantType:    grantTypeAuthorizationCode,
+		Code:         code,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	var responseJSON struct {
-		ExpiresIn int    `json:"expires_in"`
-		Token     string `json:"access_token"`
-		TokenType string `json:"token_type"`
+	a.setToken(token)
+	if err := a.saveToken(ctx, token); err != nil {
+		return nil, err
 	}
 
-	repeatCnt := 0
+	return token, nil
+}
+
+// invalidate drops the cached token unless it has been replaced already
+func (a *auth) invalidate(accessToken string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != nil && a.token.AccessToken == accessToken {
+		a.token = nil
+		a.staleToken = accessToken
+	}
+}
+
+// getToken returns a copy of the current token, refreshing it if needed
+func (a *auth) getToken(ctx context.Context, forceUpdate bool) (*Token, error) {
 	for {
-		err := a.httpClient.doRequest(helpscoutAuthEndpoint, http.MethodPost, nil, nil, &reqData, &responseJSON)
-		if err == ErrorRateLimit {
-			time.Sleep(time.Second)
-			repeatCnt++
-			if repeatCnt > 10 {
-				return "", errors.New("Unable to submit auth-token update request (rate-limit)")
+		a.mu.Lock()
+
+		/* token exists and still valid */
+		if !forceUpdate && a.token.validFor(a.expiryDelta()) {
+			token := *a.token
+			a.mu.Unlock()
+			return &token, nil
+		}
+
+		/* somebody else is refreshing the token already, wait for the result */
+		if call := a.refreshing; call != nil {
+			a.mu.Unlock()
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-call.done:
 			}
 
+			if call.err != nil && !errors.Is(call.err, context.Canceled) &&
+				!errors.Is(call.err, context.DeadlineExceeded) {
+				return nil, call.err
+			}
+
+			forceUpdate = false
 			continue
 		}
 
-		if err == ErrorUnauthorized {
-			return "", errors.Wrap(err, "Unable to submit auth-token update request (authorization failed)")
+		call := &tokenCall{done: make(chan struct{})}
+		a.refreshing = call
+		a.mu.Unlock()
+
+		/* a failing store is reported to this caller only, the token is
+		still cached so the other requests keep working */
+		var saveErr error
+		token, err := a.loadToken(ctx, forceUpdate)
+		if err == nil && token == nil {
+			if token, err = a.fetchToken(ctx); err == nil {
+				saveErr = a.saveToken(ctx, token)
+			}
+		}
+
+		a.mu.Lock()
+		if err == nil {
+			a.token = token
+			if token.RefreshToken != "" {
+				a.refreshToken = token.RefreshToken
+			}
+
+			/* copy while locked, a concurrent invalidate may drop a.token right after */
+			fetched := *token
+			token = &fetched
 		}
+		call.err = err
+		a.refreshing = nil
+		close(call.done)
+		a.mu.Unlock()
 
 		if err != nil {
-			return "", errors.Wrap(err, "Unable to submit auth-token update request")
+			return nil, err
+		}
+
+		if saveErr != nil {
+			return nil, saveErr
+		}
+
+		return token, nil
+	}
+}
+
+// loadToken returns a still valid token from the store, or nil if
+// a new one has to be requested. On a forced update only the stored
+// refresh token is picked up
+func (a *auth) loadToken(ctx context.Context, forceUpdate bool) (*Token, error) {
+	if a.store == nil {
+		return nil, nil
+	}
+
+	token, err := a.store.Load(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to load auth-token from the token store")
+	}
+
+	if token == nil {
+		return nil, nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if token.RefreshToken != "" {
+		a.refreshToken = token.RefreshToken
+	}
+
+	if forceUpdate || !token.validFor(a.expiryDelta()) || token.AccessToken == a.staleToken {
+		return nil, nil
+	}
+
+	return token, nil
+}
+
+// saveToken ..
+func (a *auth) saveToken(ctx context.Context, token *Token) error {
+	if a.store == nil {
+		return nil
+	}
+
+	if err := a.store.Save(ctx, token); err != nil {
+		return errors.Wrap(err, "Unable to save auth-token to the token store")
+	}
+
+	return nil
+}
+
+// fetchToken ..
+func (a *auth) fetchToken(ctx context.Context) (*Token, error) {
+	if a.source != nil {
+		token, err := a.source.Token(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to get auth-token from the token source")
+		}
+
+		if token == nil || token.AccessToken == "" {
+			return nil, errors.New("Token source returned an empty token")
+		}
+
+		return token, nil
+	}
+
+	a.mu.Lock()
+	refreshToken := a.refreshToken
+	a.mu.Unlock()
+
+	reqData := &authReqData{
+		ClientID:     a.appID,
+		ClientSecret: a.appKey,
+		GrantType:    grantTypeClientCredentials,
+	}
+
+	if refreshToken != "" {
+		reqData.GrantType = grantTypeRefreshToken
+		reqData.RefreshToken = refreshToken
+	}
+
+	return a.requestToken(ctx, reqData)
+}
+
+// requestToken ..
+func (a *auth) requestToken(ctx context.Context, reqData *authReqData) (*Token, error) {
+	var responseJSON struct {
+		ExpiresIn    int    `json:"expires_in"`
+		Token        string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		TokenType    string `json:"token_type"`
+	}
+
+	retryCnt := 0
+	for {
+		_, err := a.httpClient.doRequest(ctx, a.authURL, http.MethodPost, nil, nil, reqData, &responseJSON)
+		if errors.Is(err, ErrorUnauthorized) {
+			return nil, errors.Wrap(err, "Unable to submit auth-token update request (authorization failed)")
+		}
+
+		if err != nil {
+			delay, retry := a.retryPolicy.Backoff(retryCnt, http.MethodPost, err)
+			if !retry {
+				return nil, errors.Wrap(err, "Unable to submit auth-token update request")
+			}
+
+			if err := sleepContext(ctx, delay); err != nil {
+				return nil, err
+			}
+			retryCnt++
+
+			continue
 		}
 
 		break
 	}
 
 	if responseJSON.Token == "" || responseJSON.ExpiresIn <= 0 {
-		return "", errors.Errorf("Authorization server returned an invalid data: %+v", responseJSON)
+		return nil, errors.Errorf("Authorization server returned an invalid data: %+v", responseJSON)
 	}
 
-	a.token = responseJSON.Token
-	a.tokenExpireTime = time.Now().Add(time.Second * time.Duration(responseJSON.ExpiresIn))
-
-	return a.token, nil
+	return &Token{
+		AccessToken:  responseJSON.Token,
+		RefreshToken: responseJSON.RefreshToken,
+		Expiry:       time.Now().Add(time.Second * time.Duration(responseJSON.ExpiresIn)),
+	}, nil
 }
@@ -0,0 +1,270 @@
+package helpscout
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestAuthServer(t *testing.T, calls *int32) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(calls, 1)
+
+		/* keep the refresh in flight long enough for the other callers to pile up */
+		time.Sleep(10 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":7200,"token_type":"bearer"}`, n)
+	}))
+}
+
+func TestAuthGetTokenSingleFlight(t *testing.T) {
+	var calls int32
+	srv := newTestAuthServer(t, &calls)
+	defer srv.Close()
+
+	c := NewClient("id", "key", WithAuthURL(srv.URL))
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 20)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			token, err := c.auth.getToken(context.Background(), false)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			tokens[i] = token.AccessToken
+		}(i)
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Fatalf("expected a single token request, got %d", n)
+	}
+
+	for _, token := range tokens {
+		if token != "token-1" {
+			t.Fatalf("expected every caller to get token-1, got %q", token)
+		}
+	}
+}
+
+func TestAuthInvalidateRefreshesOnce(t *testing.T) {
+	var calls int32
+	srv := newTestAuthServer(t, &calls)
+	defer srv.Close()
+
+	c := NewClient("id", "key", WithAuthURL(srv.URL))
+
+	token, err := c.auth.getToken(context.Background(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	/* a stale token reported twice must only be dropped once */
+	c.auth.invalidate(token.AccessToken)
+	if _, err := c.auth.getToken(context.Background(), false); err != nil {
+		t.Fatal(err)
+	}
+	c.auth.invalidate(token.AccessToken)
+
+	refreshed, err := c.auth.getToken(context.Background(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Fatalf("expected 2 token requests, got %d", n)
+	}
+
+	if refreshed.AccessToken != "token-2" {
+		t.Fatalf("expected token-2, got %q", refreshed.AccessToken)
+	}
+}
+
+func TestAuthConcurrentInvalidate(t *testing.T) {
+	var calls int32
+	srv := newTestAuthServer(t, &calls)
+	defer srv.Close()
+
+	c := NewClient("id", "key", WithAuthURL(srv.URL))
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(3)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 20; j++ {
+				token, err := c.auth.getToken(ctx, false)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				c.auth.invalidate(token.AccessToken)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 20; j++ {
+				token, err := c.auth.Token(ctx)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+
+				if token.AccessToken == "" {
+					t.Error("Token returned an empty access token")
+					return
+				}
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 20; j++ {
+				if _, err := c.AuthKeyWithContext(ctx, false); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestAuthForceUpdateSkipsStore(t *testing.T) {
+	var calls int32
+	srv := newTestAuthServer(t, &calls)
+	defer srv.Close()
+
+	store := NewMemoryTokenStore()
+	stored := &Token{AccessToken: "stored", Expiry: time.Now().Add(time.Hour)}
+	if err := store.Save(context.Background(), stored); err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewClient("id", "key", WithAuthURL(srv.URL), WithTokenStore(store))
+
+	key, err := c.AuthKey(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if key != "stored" {
+		t.Fatalf("expected the stored token, got %q", key)
+	}
+
+	if key, err = c.AuthKey(true); err != nil {
+		t.Fatal(err)
+	}
+
+	if key != "token-1" || atomic.LoadInt32(&calls) != 1 {
+		t.Fatalf("expected a forced update to request token-1, got %q", key)
+	}
+}
+
+type testTokenSource struct {
+	calls  int32
+	expiry time.Duration
+}
+
+func (s *testTokenSource) Token(ctx context.Context) (*Token, error) {
+	n := atomic.AddInt32(&s.calls, 1)
+
+	token := &Token{AccessToken: fmt.Sprintf("source-%d", n)}
+	if s.expiry != 0 {
+		token.Expiry = time.Now().Add(s.expiry)
+	}
+
+	return token, nil
+}
+
+func TestAuthCachesSourceTokens(t *testing.T) {
+	tests := []struct {
+		name   string
+		expiry time.Duration
+	}{
+		{"no expiry", 0},
+		{"short expiry", 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &testTokenSource{expiry: tt.expiry}
+			c := NewClient("id", "key", WithTokenSource(source))
+
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					if _, err := c.auth.getToken(context.Background(), false); err != nil {
+						t.Error(err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if n := atomic.LoadInt32(&source.calls); n != 1 {
+				t.Fatalf("expected the source token to be cached, got %d calls", n)
+			}
+		})
+	}
+}
+
+func TestAuthRefreshesExpiredSourceTokens(t *testing.T) {
+	source := &testTokenSource{expiry: -time.Minute}
+	c := NewClient("id", "key", WithTokenSource(source))
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.auth.getToken(context.Background(), false); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := atomic.LoadInt32(&source.calls); n != 2 {
+		t.Fatalf("expected an expired source token to be renewed, got %d calls", n)
+	}
+}
+
+func TestAuthReportsTokenStoreErrors(t *testing.T) {
+	var calls int32
+	srv := newTestAuthServer(t, &calls)
+	defer srv.Close()
+
+	store := NewFileTokenStore(filepath.Join(t.TempDir(), "missing", "token.json"))
+	c := NewClient("id", "key", WithAuthURL(srv.URL), WithTokenStore(store))
+
+	if _, err := c.AuthKey(false); err == nil {
+		t.Fatal("expected the failing token store to be reported")
+	}
+
+	/* the fetched token is still cached in memory */
+	key, err := c.AuthKey(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if key != "token-1" || atomic.LoadInt32(&calls) != 1 {
+		t.Fatalf("expected the cached token-1, got %q", key)
+	}
+}
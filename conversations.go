@@ -1,6 +1,7 @@
 package helpscout
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -8,6 +9,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/pkg/errors"
 )
 
 // ConditionType ..
@@ -100,6 +103,7 @@ type ConversationLookupFilter struct {
 	states        *filterStringValues
 	createdPeriod *filterTimePeriod
 	updatedPeriod *filterTimePeriod
+	query         SearchQuery
 }
 
 // NewConversationLookupFilter ..
@@ -167,6 +171,11 @@ func (f *ConversationLookupFilter) ModifiedTime(from time.Time, to time.Time, cT
 	f.updatedPeriod.Set(from, to, getConditionType(cType))
 }
 
+// Query ..
+func (f *ConversationLookupFilter) Query(q SearchQuery) {
+	f.query = q
+}
+
 // AnsweredBy ..
 type AnsweredBy struct {
 	Time               time.Time `json:"time"`
@@ -185,6 +194,11 @@ type ConversationCustomer struct {
 	ID int `json:"id"`
 }
 
+// ConversationEmbedded ..
+type ConversationEmbedded struct {
+	Threads []Thread `json:"threads"`
+}
+
 // Conversation ..
 type Conversation struct {
 	ID              int                  `json:"id"`
@@ -210,6 +224,33 @@ type Conversation struct {
 	BCC             []string             `json:"bcc"`
 	PrimaryCustomer ConversationCustomer `json:"primaryCustomer"`
 	CustomFields    []CustomField        `json:"customFields"`
+	Embedded        ConversationEmbedded `json:"_embedded"`
+}
+
+// CustomerRef ..
+type CustomerRef struct {
+	ID        int    `json:"id,omitempty"`
+	Email     string `json:"email,omitempty"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+}
+
+// NewConversation ..
+type NewConversation struct {
+	Subject   string             `json:"subject"`
+	Customer  CustomerRef        `json:"customer"`
+	MailboxID int                `json:"mailboxId"`
+	Type      string             `json:"type"`
+	Status    string             `json:"status"`
+	Threads   []NewThread        `json:"threads"`
+	Tags      []string           `json:"tags,omitempty"`
+	Fields    []CustomFieldValue `json:"fields,omitempty"`
+	AssignTo  int                `json:"assignTo,omitempty"`
+	User      int                `json:"user,omitempty"`
+	Imported  bool               `json:"imported,omitempty"`
+	AutoReply bool               `json:"autoReply,omitempty"`
+	CreatedAt *time.Time         `json:"createdAt,omitempty"`
+	ClosedAt  *time.Time         `json:"closedAt,omitempty"`
 }
 
 // ConverationResponse ..
@@ -220,76 +261,154 @@ type ConverationResponse struct {
 
 // List ..
 func (c *Client) List(query *url.Values, conversations chan ConverationResponse, done chan bool) {
-	query.Del("page")
-	var check ConverationResponse
-	req := &generalListAPICallReq{Embedded: &check}
+	c.ListWithContext(context.Background(), query, conversations, done)
+}
+
+// ListWithContext fetches every page of conversations matching query and
+// sends each page to conversations exactly once, using at most the
+// configured number of concurrent requests. Once the first page fails no
+// further pages are requested, so at most one response carries an Error.
+// done is signalled exactly once after the last response has been sent.
+func (c *Client) ListWithContext(ctx context.Context, query *url.Values,
+	conversations chan ConverationResponse, done chan bool) {
+
+	defer func() {
+		done <- true
+	}()
 
 	// Let's do an initial call to the API and figure out how many pages we have, return early if we have no work
-	err := c.doAPICall(http.MethodGet, "/conversations", query, nil, req)
-	if err != nil {
-		check.Error = err
-		conversations <- check
+	first, page := c.listConversationsPage(ctx, query, 0)
+	conversations <- first
+	if first.Error != nil || page.Number >= page.TotalPages {
 		return
 	}
 
-	if req.Page.Number == req.Page.TotalPages {
-		conversations <- check
-		return
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workers := c.concurrency
+	if remaining := page.TotalPages - page.Number; workers > remaining {
+		workers = remaining
 	}
 
-	// Fetch all remaining pages
+	var failed sync.Once
 	var wg sync.WaitGroup
-	for i := req.Page.Number + 1; i < req.Page.TotalPages; i++ {
-		query.Set("page", strconv.Itoa(i))
-		go func(q *url.Values) {
-			wg.Add(1)
+	pages := make(chan int)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
 			defer wg.Done()
 
-			var response ConverationResponse
-
-			r := &generalListAPICallReq{
-				Embedded: &response,
+			for n := range pages {
+				response, _ := c.listConversationsPage(ctx, query, n)
+				if response.Error == nil {
+					conversations <- response
+					continue
+				}
+
+				failed.Do(func() {
+					cancel()
+					conversations <- response
+				})
 			}
+		}()
+	}
 
-			err := c.doAPICall(http.MethodGet, "/conversations", q, nil, r)
-			response.Error = err
-			conversations <- response
-		}(query)
+	// Fetch all remaining pages
+feed:
+	for n := page.Number + 1; n <= page.TotalPages; n++ {
+		select {
+		case pages <- n:
+		case <-ctx.Done():
+			break feed
+		}
 	}
 
+	close(pages)
 	wg.Wait()
-	done <- true
+}
+
+// listConversationsPage ..
+func (c *Client) listConversationsPage(ctx context.Context, query *url.Values, page int) (ConverationResponse, Page) {
+	q := cloneQuery(query)
+	q.Del("page")
+	if page > 0 {
+		q.Set("page", strconv.Itoa(page))
+	}
+
+	var response ConverationResponse
+	req := &generalListAPICallReq{Embedded: &response}
+
+	response.Error = c.doAPICall(ctx, http.MethodGet, "/conversations", &q, nil, req)
+
+	return response, req.Page
+}
+
+// ConversationIterator ..
+type ConversationIterator struct {
+	*Pager
+}
+
+// IterateConversations ..
+func (c *Client) IterateConversations(query *url.Values) *ConversationIterator {
+	return &ConversationIterator{c.newPager("/conversations", "conversations", query,
+		func() interface{} { return &Conversation{} })}
+}
+
+// Value ..
+func (it *ConversationIterator) Value() Conversation {
+	if v, ok := it.Pager.Value().(*Conversation); ok {
+		return *v
+	}
+
+	return Conversation{}
+}
+
+// All ..
+func (it *ConversationIterator) All(ctx context.Context) ([]Conversation, error) {
+	var conversations []Conversation
+	for it.Next(ctx) {
+		conversations = append(conversations, it.Value())
+	}
+
+	return conversations, it.Err()
 }
 
 // PrepareListOfStatuses ..
 func (c *Client) PrepareListOfStatuses(filter *ConversationLookupFilter) []string {
-	var statuses []string
-	if filter.statuses != nil {
-		switch filter.statuses.cType {
-		case Inclusively:
-			statuses = append(statuses, filter.statuses.values...)
-		case Exclusively:
-			m := map[string]int{
-				ConversationStatusOpen:    0,
-				ConversationStatusClosed:  0,
-				ConversationStatusActive:  0,
-				ConversationStatusPending: 0,
-				ConversationStatusSpam:    0,
-			}
+	if filter.statuses == nil {
+		return nil
+	}
 
-			for _, v := range filter.statuses.values {
-				delete(m, v)
+	values := append([]string(nil), filter.statuses.values...)
+
+	/* "open" is not a searchable status but a shortcut for active and pending,
+	only the status parameter of a single status filter understands it */
+	if filter.statuses.cType == Exclusively || len(values) > 1 {
+		var expanded []string
+		seen := make(map[string]bool, len(values)+1)
+		for _, v := range values {
+			statuses := []string{v}
+			if v == ConversationStatusOpen {
+				statuses = []string{ConversationStatusActive, ConversationStatusPending}
 			}
 
-			for k := range m {
-				statuses = append(statuses, k)
+			for _, status := range statuses {
+				if !seen[status] {
+					seen[status] = true
+					expanded = append(expanded, status)
+				}
 			}
-		default:
-			panic("Unknown condition type")
 		}
+		values = expanded
 	}
 
-	return statuses
+	return resolveConditionValues(values, filter.statuses.cType, []string{
+		ConversationStatusActive,
+		ConversationStatusPending,
+		ConversationStatusClosed,
+		ConversationStatusSpam,
+	})
 }
 
 // PrepareListConversationQuery ..
@@ -298,45 +417,212 @@ func (c *Client) PrepareListConversationQuery(filter *ConversationLookupFilter)
 		return &url.Values{}, nil
 	}
 
-	queryValues := []string{}
+	query := url.Values{}
+	terms := []SearchQuery{}
 
-	if filter.mailboxIds != nil {
+	if filter.mailboxIds != nil && len(filter.mailboxIds.values) != 0 {
 		b := make([]string, len(filter.mailboxIds.values))
 		for i, v := range filter.mailboxIds.values {
-			b[i] = fmt.Sprintf("mailboxid:%d", v)
+			b[i] = strconv.Itoa(v)
 		}
 
-		queryValues = append(queryValues, fmt.Sprintf("(%s)", strings.Join(b, " OR ")))
+		switch filter.mailboxIds.cType {
+		case Inclusively:
+			query.Set("mailbox", strings.Join(b, ","))
+		case Exclusively:
+			terms = append(terms, SearchNot(SearchMailboxIDs(filter.mailboxIds.values...)))
+		default:
+			panic("Unknown condition type")
+		}
+	}
+
+	if filter.statuses != nil {
+		statuses := c.PrepareListOfStatuses(filter)
+		switch len(statuses) {
+		case 0:
+			/* an empty inclusive list does not filter by status at all */
+			if filter.statuses.cType == Exclusively {
+				return nil, errors.New("Status filter excludes every conversation status")
+			}
+		case 1:
+			query.Set("status", statuses[0])
+		default:
+			query.Set("status", "all")
+			terms = append(terms, SearchStatus(statuses...))
+		}
+	}
+
+	if filter.types != nil {
+		types := resolveConditionValues(filter.types.values, filter.types.cType, []string{
+			ConversationTypeEmail,
+			ConversationTypeChat,
+			ConversationTypePhone,
+		})
+		switch {
+		case len(types) != 0:
+			terms = append(terms, SearchType(types...))
+		case filter.types.cType == Exclusively:
+			return nil, errors.New("Type filter excludes every conversation type")
+		}
+	}
+
+	if filter.states != nil {
+		states := resolveConditionValues(filter.states.values, filter.states.cType, []string{
+			ConversationStatePublished,
+			ConversationStateDraft,
+			ConversationStateDeleted,
+		})
+		switch {
+		case len(states) != 0:
+			terms = append(terms, SearchState(states...))
+		case filter.states.cType == Exclusively:
+			return nil, errors.New("State filter excludes every conversation state")
+		}
 	}
 
 	if filter.createdPeriod != nil {
-		fromStr, toStr := formatFromToTimePeriod(filter.createdPeriod.from, filter.createdPeriod.to)
-		queryValues = append(queryValues, fmt.Sprintf("createdAt:[%s TO %s]", fromStr, toStr))
+		terms = append(terms, timePeriodTerm("createdAt", filter.createdPeriod))
 	}
 
 	if filter.updatedPeriod != nil {
-		fromStr, toStr := formatFromToTimePeriod(filter.updatedPeriod.from, filter.updatedPeriod.to)
-		queryValues = append(queryValues, fmt.Sprintf("modifiedAt:[%s TO %s]", fromStr, toStr))
+		terms = append(terms, timePeriodTerm("modifiedAt", filter.updatedPeriod))
 	}
 
-	query := url.Values{}
-	if len(queryValues) != 0 {
-		query.Set("query", fmt.Sprintf("(%s)", strings.Join(queryValues, " AND ")))
+	if filter.query != nil {
+		terms = append(terms, filter.query)
+	}
+
+	if q := searchQueryParam(SearchAnd(terms...)); q != "" {
+		query.Set("query", q)
 	}
 
 	return &query, nil
 }
 
+// resolveConditionValues turns an exclusive condition into the inclusive
+// list of the remaining values of all
+func resolveConditionValues(values []string, cType ConditionType, all []string) []string {
+	switch cType {
+	case Inclusively:
+		return values
+	case Exclusively:
+		excluded := make(map[string]bool, len(values))
+		for _, v := range values {
+			excluded[v] = true
+		}
+
+		var result []string
+		for _, v := range all {
+			if !excluded[v] {
+				result = append(result, v)
+			}
+		}
+
+		return result
+	default:
+		panic("Unknown condition type")
+	}
+}
+
+// timePeriodTerm ..
+func timePeriodTerm(field string, period *filterTimePeriod) SearchQuery {
+	term := SearchTimeRange(field, period.from, period.to)
+
+	switch period.cType {
+	case Inclusively:
+		return term
+	case Exclusively:
+		return SearchNot(term)
+	default:
+		panic("Unknown condition type")
+	}
+}
+
 func formatFromToTimePeriod(from time.Time, to time.Time) (string, string) {
 	fromStr := "*"
 	if !from.IsZero() {
-		fromStr = from.Format("2006-01-02T15:04:05Z")
+		fromStr = from.UTC().Format("2006-01-02T15:04:05Z")
 	}
 
 	toStr := "*"
 	if !to.IsZero() {
-		toStr = to.Format("2006-01-02T15:04:05Z")
+		toStr = to.UTC().Format("2006-01-02T15:04:05Z")
 	}
 
 	return fromStr, toStr
 }
+
+// GetConversation ..
+func (c *Client) GetConversation(ctx context.Context, id int) (*Conversation, error) {
+	return c.getConversation(ctx, id, nil)
+}
+
+// GetConversationWithThreads ..
+func (c *Client) GetConversationWithThreads(ctx context.Context, id int) (*Conversation, error) {
+	return c.getConversation(ctx, id, &url.Values{"embed": []string{"threads"}})
+}
+
+// getConversation ..
+func (c *Client) getConversation(ctx context.Context, id int, query *url.Values) (*Conversation, error) {
+	var conversation Conversation
+
+	resource := fmt.Sprintf("/conversations/%d", id)
+	if err := c.doAPICall(ctx, http.MethodGet, resource, query, nil, &conversation); err != nil {
+		return nil, err
+	}
+
+	return &conversation, nil
+}
+
+// CreateConversation ..
+func (c *Client) CreateConversation(ctx context.Context, conversation *NewConversation) (int, error) {
+	return c.createResource(ctx, "/conversations", conversation)
+}
+
+// UpdateConversation ..
+func (c *Client) UpdateConversation(ctx context.Context, id int, ops ...PatchOperation) error {
+	resource := fmt.Sprintf("/conversations/%d", id)
+
+	/* the endpoint accepts a single operation per request */
+	for _, op := range ops {
+		if err := c.doAPICall(ctx, http.MethodPatch, resource, nil, op, nil); err != nil {
+			return errors.Wrapf(err, "Unable to apply %s %s", op.Op, op.Path)
+		}
+	}
+
+	return nil
+}
+
+// DeleteConversation ..
+func (c *Client) DeleteConversation(ctx context.Context, id int) error {
+	return c.doAPICall(ctx, http.MethodDelete, fmt.Sprintf("/conversations/%d", id), nil, nil, nil)
+}
+
+// PatchConversationSubject ..
+func PatchConversationSubject(subject string) PatchOperation {
+	return PatchReplace("/subject", subject)
+}
+
+// PatchConversationStatus ..
+func PatchConversationStatus(status string) PatchOperation {
+	return PatchReplace("/status", status)
+}
+
+// PatchConversationAssignee assigns the conversation to a user or team ID, 0 unassigns it
+func PatchConversationAssignee(userID int) PatchOperation {
+	if userID == 0 {
+		return PatchRemove("/assignTo")
+	}
+
+	return PatchReplace("/assignTo", userID)
+}
+
+// PatchConversationMailbox ..
+func PatchConversationMailbox(mailboxID int) PatchOperation {
+	return PatchOperation{Op: "move", Path: "/mailboxId", Value: mailboxID}
+}
+
+// PatchConversationPrimaryCustomer ..
+func PatchConversationPrimaryCustomer(customerID int) PatchOperation {
+	return PatchReplace("/primaryCustomer.id", customerID)
+}
@@ -0,0 +1,210 @@
+package helpscout
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestConversationsServer(t *testing.T, totalPages int, failPage int) (*httptest.Server, map[int]int, *sync.Mutex) {
+	t.Helper()
+
+	var mu sync.Mutex
+	requested := make(map[int]int)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			page, _ = strconv.Atoi(p)
+		}
+
+		mu.Lock()
+		requested[page]++
+		mu.Unlock()
+
+		if page == failPage {
+			http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/hal+json")
+		fmt.Fprintf(w, `{"_embedded":{"conversations":[{"id":%d}]},"page":{"size":1,"totalElements":%d,"totalPages":%d,"number":%d}}`,
+			page, totalPages, totalPages, page)
+	}))
+
+	return srv, requested, &mu
+}
+
+func collectConversations(c *Client) []ConverationResponse {
+	conversations := make(chan ConverationResponse)
+	done := make(chan bool)
+
+	go c.List(nil, conversations, done)
+
+	var responses []ConverationResponse
+	for {
+		select {
+		case response := <-conversations:
+			responses = append(responses, response)
+		case <-done:
+			/* make sure nothing else is delivered after done */
+			select {
+			case response := <-conversations:
+				responses = append(responses, response)
+			case <-time.After(50 * time.Millisecond):
+			}
+
+			return responses
+		}
+	}
+}
+
+func TestListFetchesEveryPageOnce(t *testing.T) {
+	srv, requested, mu := newTestConversationsServer(t, 7, 0)
+	defer srv.Close()
+
+	c := NewClient("id", "key", WithBaseURL(srv.URL), WithConcurrency(3))
+	c.SetAuthKey("token", time.Now().Add(time.Hour))
+
+	responses := collectConversations(c)
+	if len(responses) != 7 {
+		t.Fatalf("expected 7 pages, got %d", len(responses))
+	}
+
+	seen := make(map[int]bool)
+	for _, response := range responses {
+		if response.Error != nil {
+			t.Fatal(response.Error)
+		}
+
+		for _, conversation := range response.Conversations {
+			if seen[conversation.ID] {
+				t.Fatalf("page %d delivered twice", conversation.ID)
+			}
+			seen[conversation.ID] = true
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	for page := 1; page <= 7; page++ {
+		if !seen[page] || requested[page] != 1 {
+			t.Fatalf("expected page %d to be requested and delivered once, requested %d times", page, requested[page])
+		}
+	}
+}
+
+func TestListReportsSingleError(t *testing.T) {
+	srv, _, _ := newTestConversationsServer(t, 20, 3)
+	defer srv.Close()
+
+	c := NewClient("id", "key", WithBaseURL(srv.URL), WithConcurrency(4))
+	c.SetAuthKey("token", time.Now().Add(time.Hour))
+
+	responses := collectConversations(c)
+
+	failures := 0
+	for _, response := range responses {
+		if response.Error != nil {
+			failures++
+		}
+	}
+
+	if failures != 1 {
+		t.Fatalf("expected exactly one failed page, got %d", failures)
+	}
+}
+
+func TestListSinglePage(t *testing.T) {
+	srv, requested, mu := newTestConversationsServer(t, 1, 0)
+	defer srv.Close()
+
+	c := NewClient("id", "key", WithBaseURL(srv.URL))
+	c.SetAuthKey("token", time.Now().Add(time.Hour))
+
+	responses := collectConversations(c)
+	if len(responses) != 1 || responses[0].Error != nil {
+		t.Fatalf("expected a single successful page, got %+v", responses)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(requested) != 1 {
+		t.Fatalf("expected a single request, got %v", requested)
+	}
+}
+
+func TestPrepareListConversationQueryEmptyLists(t *testing.T) {
+	c := NewClient("id", "key")
+
+	filter := &ConversationLookupFilter{}
+	filter.Status([]string{})
+	filter.Type([]string{})
+	filter.State([]string{})
+
+	query, err := c.PrepareListConversationQuery(filter)
+	if err != nil {
+		t.Fatalf("expected empty inclusive lists to be ignored, got %v", err)
+	}
+
+	if len(*query) != 0 {
+		t.Fatalf("expected an empty query, got %v", query.Encode())
+	}
+
+	filter = &ConversationLookupFilter{}
+	filter.Type([]string{ConversationTypeEmail, ConversationTypeChat, ConversationTypePhone}, Exclusively)
+	if _, err := c.PrepareListConversationQuery(filter); err == nil {
+		t.Fatal("expected an error for a type filter excluding every type")
+	}
+}
+
+func TestFormatFromToTimePeriodUTC(t *testing.T) {
+	zone := time.FixedZone("UTC+10", 10*60*60)
+	from := time.Date(2020, 1, 2, 10, 0, 0, 0, zone)
+
+	fromStr, toStr := formatFromToTimePeriod(from, time.Time{})
+	if fromStr != "2020-01-02T00:00:00Z" || toStr != "*" {
+		t.Fatalf("expected UTC timestamps, got %q and %q", fromStr, toStr)
+	}
+}
+
+func TestPrepareListConversationQueryOpenStatus(t *testing.T) {
+	c := NewClient("id", "key")
+
+	tests := []struct {
+		name     string
+		statuses []string
+		cType    ConditionType
+		status   string
+		query    string
+	}{
+		{"single open", []string{"open"}, Inclusively, "open", ""},
+		{"open and closed", []string{"open", "closed"}, Inclusively, "all",
+			"(status:active OR status:pending OR status:closed)"},
+		{"open and active", []string{"active", "open"}, Inclusively, "all", "(status:active OR status:pending)"},
+		{"not open", []string{"open"}, Exclusively, "all", "(status:closed OR status:spam)"},
+		{"not open nor spam", []string{"open", "spam"}, Exclusively, "closed", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := &ConversationLookupFilter{}
+			filter.Status(tt.statuses, tt.cType)
+
+			query, err := c.PrepareListConversationQuery(filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if query.Get("status") != tt.status || query.Get("query") != tt.query {
+				t.Fatalf("expected status=%s query=%s, got %s", tt.status, tt.query, query.Encode())
+			}
+		})
+	}
+}
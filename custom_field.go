@@ -1,8 +1,204 @@
 package helpscout
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// customFieldDateLayout ..
+const customFieldDateLayout = "2006-01-02"
+
 // CustomField ..
 type CustomField struct {
 	ID    int    `json:"id"`
 	Name  string `json:"name"`
 	Value string `json:"value"`
+	Text  string `json:"text"`
+}
+
+// Time ..
+func (f CustomField) Time() (time.Time, error) {
+	t, err := time.Parse(customFieldDateLayout, f.Value)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "Custom field %d is not a date", f.ID)
+	}
+
+	return t, nil
+}
+
+// Number ..
+func (f CustomField) Number() (float64, error) {
+	n, err := strconv.ParseFloat(f.Value, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Custom field %d is not a number", f.ID)
+	}
+
+	return n, nil
+}
+
+// OptionID ..
+func (f CustomField) OptionID() (int, error) {
+	id, err := strconv.Atoi(f.Value)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Custom field %d is not a dropdown option", f.ID)
+	}
+
+	return id, nil
+}
+
+// Decode returns the value as the Go type matching the field definition:
+// string for text fields, time.Time for dates, float64 for numbers and
+// the selected MailboxFieldOption for dropdowns
+func (f CustomField) Decode(def MailboxField) (interface{}, error) {
+	switch def.Type {
+	case MailboxFieldTypeSingleLine, MailboxFieldTypeMultiLine:
+		return f.Value, nil
+	case MailboxFieldTypeDate:
+		return f.Time()
+	case MailboxFieldTypeNumber:
+		return f.Number()
+	case MailboxFieldTypeDropdown:
+		id, err := f.OptionID()
+		if err != nil {
+			return nil, err
+		}
+
+		option, ok := def.Option(id)
+		if !ok {
+			return nil, errors.Errorf("Custom field %d has an unknown option %d", f.ID, id)
+		}
+
+		return option, nil
+	}
+
+	return nil, errors.Errorf("Custom field %d has an unknown type %s", f.ID, def.Type)
+}
+
+// CustomFieldValue ..
+type CustomFieldValue struct {
+	ID    int    `json:"id"`
+	Value string `json:"value"`
+
+	/* field types the value was constructed for, empty if unknown */
+	types []string
+}
+
+// TextFieldValue ..
+func TextFieldValue(id int, text string) CustomFieldValue {
+	return CustomFieldValue{
+		ID:    id,
+		Value: text,
+		types: []string{MailboxFieldTypeSingleLine, MailboxFieldTypeMultiLine},
+	}
+}
+
+// DateFieldValue ..
+func DateFieldValue(id int, date time.Time) CustomFieldValue {
+	return CustomFieldValue{
+		ID:    id,
+		Value: date.Format(customFieldDateLayout),
+		types: []string{MailboxFieldTypeDate},
+	}
+}
+
+// NumberFieldValue ..
+func NumberFieldValue(id int, number float64) CustomFieldValue {
+	return CustomFieldValue{
+		ID:    id,
+		Value: strconv.FormatFloat(number, 'f', -1, 64),
+		types: []string{MailboxFieldTypeNumber},
+	}
+}
+
+// DropdownFieldValue ..
+func DropdownFieldValue(id int, optionID int) CustomFieldValue {
+	return CustomFieldValue{
+		ID:    id,
+		Value: strconv.Itoa(optionID),
+		types: []string{MailboxFieldTypeDropdown},
+	}
+}
+
+// ValidateCustomFields checks values against the field definitions of a
+// mailbox, the returned error matches ErrorValidation
+func ValidateCustomFields(defs []MailboxField, values []CustomFieldValue) error {
+	byID := make(map[int]MailboxField, len(defs))
+	for _, d := range defs {
+		byID[d.ID] = d
+	}
+
+	for _, v := range values {
+		def, ok := byID[v.ID]
+		if !ok {
+			return errors.Wrapf(ErrorValidation, "Custom field %d does not exist in the mailbox", v.ID)
+		}
+
+		if len(v.types) != 0 && !containsString(v.types, def.Type) {
+			return errors.Wrapf(ErrorValidation, "Custom field %d (%s) is a %s field, not %s",
+				v.ID, def.Name, def.Type, strings.Join(v.types, "/"))
+		}
+
+		if v.Value == "" {
+			if def.Required {
+				return errors.Wrapf(ErrorValidation, "Custom field %d (%s) is required", v.ID, def.Name)
+			}
+
+			continue
+		}
+
+		if _, err := (CustomField{ID: v.ID, Value: v.Value}).Decode(def); err != nil {
+			return errors.Wrapf(ErrorValidation, "Custom field %d (%s) has an invalid value: %s", v.ID, def.Name, err)
+		}
+
+		if def.Type == MailboxFieldTypeSingleLine && strings.ContainsAny(v.Value, "\r\n") {
+			return errors.Wrapf(ErrorValidation, "Custom field %d (%s) must be a single line", v.ID, def.Name)
+		}
+	}
+
+	return nil
+}
+
+// SetConversationFields validates values against the field definitions of
+// the conversation's mailbox before updating them
+func (c *Client) SetConversationFields(ctx context.Context, conversationID int, values ...CustomFieldValue) error {
+	conversation, err := c.GetConversation(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+
+	defs, err := c.IterateMailboxCustomFields(conversation.MailboxID).All(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Unable to load mailbox custom fields")
+	}
+
+	if err := ValidateCustomFields(defs, values); err != nil {
+		return err
+	}
+
+	reqData := struct {
+		Fields []CustomFieldValue `json:"fields"`
+	}{
+		Fields: values,
+	}
+
+	resource := fmt.Sprintf("/conversations/%d/fields", conversationID)
+
+	return c.doAPICall(ctx, http.MethodPut, resource, nil, &reqData, nil)
+}
+
+// containsString ..
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,55 @@
+package helpscout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestValidateCustomFields(t *testing.T) {
+	defs := []MailboxField{
+		{ID: 1, Name: "Order", Type: MailboxFieldTypeSingleLine, Required: true},
+		{ID: 2, Name: "Notes", Type: MailboxFieldTypeMultiLine},
+		{ID: 3, Name: "Due", Type: MailboxFieldTypeDate},
+		{ID: 4, Name: "Amount", Type: MailboxFieldTypeNumber},
+		{ID: 5, Name: "Plan", Type: MailboxFieldTypeDropdown, Options: []MailboxFieldOption{{ID: 50, Label: "Pro"}}},
+	}
+
+	tests := []struct {
+		name   string
+		values []CustomFieldValue
+		valid  bool
+	}{
+		{"valid values", []CustomFieldValue{
+			TextFieldValue(1, "A-1"),
+			TextFieldValue(2, "first\nsecond"),
+			DateFieldValue(3, time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)),
+			NumberFieldValue(4, 12.5),
+			DropdownFieldValue(5, 50),
+		}, true},
+		{"no values", nil, true},
+		{"empty optional field", []CustomFieldValue{TextFieldValue(2, "")}, true},
+		{"unknown field", []CustomFieldValue{TextFieldValue(9, "x")}, false},
+		{"wrong type", []CustomFieldValue{NumberFieldValue(1, 3)}, false},
+		{"text for a date", []CustomFieldValue{TextFieldValue(3, "tomorrow")}, false},
+		{"invalid raw number", []CustomFieldValue{{ID: 4, Value: "twelve"}}, false},
+		{"unknown option", []CustomFieldValue{DropdownFieldValue(5, 51)}, false},
+		{"empty required field", []CustomFieldValue{TextFieldValue(1, "")}, false},
+		{"newline in single line", []CustomFieldValue{TextFieldValue(1, "A-1\nA-2")}, false},
+		{"carriage return in single line", []CustomFieldValue{TextFieldValue(1, "A-1\r")}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCustomFields(defs, tt.values)
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid values, got %v", err)
+			}
+
+			if !tt.valid && !errors.Is(err, ErrorValidation) {
+				t.Fatalf("expected a validation error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package helpscout
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CustomerEmail ..
+type CustomerEmail struct {
+	ID    int    `json:"id,omitempty"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// CustomerPhone ..
+type CustomerPhone struct {
+	ID    int    `json:"id,omitempty"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// CustomerChat ..
+type CustomerChat struct {
+	ID    int    `json:"id,omitempty"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// CustomerSocialProfile ..
+type CustomerSocialProfile struct {
+	ID    int    `json:"id,omitempty"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// CustomerWebsite ..
+type CustomerWebsite struct {
+	ID    int    `json:"id,omitempty"`
+	Value string `json:"value"`
+}
+
+// CustomerAddress ..
+type CustomerAddress struct {
+	City       string   `json:"city"`
+	State      string   `json:"state"`
+	PostalCode string   `json:"postalCode"`
+	Country    string   `json:"country"`
+	Lines      []string `json:"lines"`
+}
+
+// ListCustomerEmails ..
+func (c *Client) ListCustomerEmails(ctx context.Context, customerID int) ([]CustomerEmail, error) {
+	var emails []CustomerEmail
+	err := c.getEmbedded(ctx, fmt.Sprintf("/customers/%d/emails", customerID), "emails", &emails)
+
+	return emails, err
+}
+
+// CreateCustomerEmail ..
+func (c *Client) CreateCustomerEmail(ctx context.Context, customerID int, email CustomerEmail) (int, error) {
+	return c.createResource(ctx, fmt.Sprintf("/customers/%d/emails", customerID), email)
+}
+
+// UpdateCustomerEmail ..
+func (c *Client) UpdateCustomerEmail(ctx context.Context, customerID int, email CustomerEmail) error {
+	resource := fmt.Sprintf("/customers/%d/emails/%d", customerID, email.ID)
+
+	return c.doAPICall(ctx, http.MethodPut, resource, nil, email, nil)
+}
+
+// DeleteCustomerEmail ..
+func (c *Client) DeleteCustomerEmail(ctx context.Context, customerID int, emailID int) error {
+	resource := fmt.Sprintf("/customers/%d/emails/%d", customerID, emailID)
+
+	return c.doAPICall(ctx, http.MethodDelete, resource, nil, nil, nil)
+}
+
+// ListCustomerPhones ..
+func (c *Client) ListCustomerPhones(ctx context.Context, customerID int) ([]CustomerPhone, error) {
+	var phones []CustomerPhone
+	err := c.getEmbedded(ctx, fmt.Sprintf("/customers/%d/phones", customerID), "phones", &phones)
+
+	return phones, err
+}
+
+// CreateCustomerPhone ..
+func (c *Client) CreateCustomerPhone(ctx context.Context, customerID int, phone CustomerPhone) (int, error) {
+	return c.createResource(ctx, fmt.Sprintf("/customers/%d/phones", customerID), phone)
+}
+
+// UpdateCustomerPhone ..
+func (c *Client) UpdateCustomerPhone(ctx context.Context, customerID int, phone CustomerPhone) error {
+	resource := fmt.Sprintf("/customers/%d/phones/%d", customerID, phone.ID)
+
+	return c.doAPICall(ctx, http.MethodPut, resource, nil, phone, nil)
+}
+
+// DeleteCustomerPhone ..
+func (c *Client) DeleteCustomerPhone(ctx context.Context, customerID int, phoneID int) error {
+	resource := fmt.Sprintf("/customers/%d/phones/%d", customerID, phoneID)
+
+	return c.doAPICall(ctx, http.MethodDelete, resource, nil, nil, nil)
+}
+
+// ListCustomerChats ..
+func (c *Client) ListCustomerChats(ctx context.Context, customerID int) ([]CustomerChat, error) {
+	var chats []CustomerChat
+	err := c.getEmbedded(ctx, fmt.Sprintf("/customers/%d/chats", customerID), "chats", &chats)
+
+	return chats, err
+}
+
+// CreateCustomerChat ..
+func (c *Client) CreateCustomerChat(ctx context.Context, customerID int, chat CustomerChat) (int, error) {
+	return c.createResource(ctx, fmt.Sprintf("/customers/%d/chats", customerID), chat)
+}
+
+// UpdateCustomerChat ..
+func (c *Client) UpdateCustomerChat(ctx context.Context, customerID int, chat CustomerChat) error {
+	resource := fmt.Sprintf("/customers/%d/chats/%d", customerID, chat.ID)
+
+	return c.doAPICall(ctx, http.MethodPut, resource, nil, chat, nil)
+}
+
+// DeleteCustomerChat ..
+func (c *Client) DeleteCustomerChat(ctx context.Context, customerID int, chatID int) error {
+	resource := fmt.Sprintf("/customers/%d/chats/%d", customerID, chatID)
+
+	return c.doAPICall(ctx, http.MethodDelete, resource, nil, nil, nil)
+}
+
+// ListCustomerSocialProfiles ..
+func (c *Client) ListCustomerSocialProfiles(ctx context.Context, customerID int) ([]CustomerSocialProfile, error) {
+	var profiles []CustomerSocialProfile
+	resource := fmt.Sprintf("/customers/%d/social-profiles", customerID)
+	err := c.getEmbedded(ctx, resource, "social_profiles", &profiles)
+
+	return profiles, err
+}
+
+// CreateCustomerSocialProfile ..
+func (c *Client) CreateCustomerSocialProfile(ctx context.Context, customerID int,
+	profile CustomerSocialProfile) (int, error) {
+
+	return c.createResource(ctx, fmt.Sprintf("/customers/%d/social-profiles", customerID), profile)
+}
+
+// UpdateCustomerSocialProfile ..
+func (c *Client) UpdateCustomerSocialProfile(ctx context.Context, customerID int, profile CustomerSocialProfile) error {
+	resource := fmt.Sprintf("/customers/%d/social-profiles/%d", customerID, profile.ID)
+
+	return c.doAPICall(ctx, http.MethodPut, resource, nil, profile, nil)
+}
+
+// DeleteCustomerSocialProfile ..
+func (c *Client) DeleteCustomerSocialProfile(ctx context.Context, customerID int, profileID int) error {
+	resource := fmt.Sprintf("/customers/%d/social-profiles/%d", customerID, profileID)
+
+	return c.doAPICall(ctx, http.MethodDelete, resource, nil, nil, nil)
+}
+
+// ListCustomerWebsites ..
+func (c *Client) ListCustomerWebsites(ctx context.Context, customerID int) ([]CustomerWebsite, error) {
+	var websites []CustomerWebsite
+	err := c.getEmbedded(ctx, fmt.Sprintf("/customers/%d/websites", customerID), "websites", &websites)
+
+	return websites, err
+}
+
+// CreateCustomerWebsite ..
+func (c *Client) CreateCustomerWebsite(ctx context.Context, customerID int, website CustomerWebsite) (int, error) {
+	return c.createResource(ctx, fmt.Sprintf("/customers/%d/websites", customerID), website)
+}
+
+// UpdateCustomerWebsite ..
+func (c *Client) UpdateCustomerWebsite(ctx context.Context, customerID int, website CustomerWebsite) error {
+	resource := fmt.Sprintf("/customers/%d/websites/%d", customerID, website.ID)
+
+	return c.doAPICall(ctx, http.MethodPut, resource, nil, website, nil)
+}
+
+// DeleteCustomerWebsite ..
+func (c *Client) DeleteCustomerWebsite(ctx context.Context, customerID int, websiteID int) error {
+	resource := fmt.Sprintf("/customers/%d/websites/%d", customerID, websiteID)
+
+	return c.doAPICall(ctx, http.MethodDelete, resource, nil, nil, nil)
+}
+
+// GetCustomerAddress ..
+func (c *Client) GetCustomerAddress(ctx context.Context, customerID int) (*CustomerAddress, error) {
+	var address CustomerAddress
+	resource := fmt.Sprintf("/customers/%d/address", customerID)
+	if err := c.doAPICall(ctx, http.MethodGet, resource, nil, nil, &address); err != nil {
+		return nil, err
+	}
+
+	return &address, nil
+}
+
+// CreateCustomerAddress ..
+func (c *Client) CreateCustomerAddress(ctx context.Context, customerID int, address *CustomerAddress) error {
+	resource := fmt.Sprintf("/customers/%d/address", customerID)
+
+	return c.doAPICall(ctx, http.MethodPost, resource, nil, address, nil)
+}
+
+// UpdateCustomerAddress ..
+func (c *Client) UpdateCustomerAddress(ctx context.Context, customerID int, address *CustomerAddress) error {
+	resource := fmt.Sprintf("/customers/%d/address", customerID)
+
+	return c.doAPICall(ctx, http.MethodPut, resource, nil, address, nil)
+}
+
+// DeleteCustomerAddress ..
+func (c *Client) DeleteCustomerAddress(ctx context.Context, customerID int) error {
+	resource := fmt.Sprintf("/customers/%d/address", customerID)
+
+	return c.doAPICall(ctx, http.MethodDelete, resource, nil, nil, nil)
+}
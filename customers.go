@@ -1,8 +1,206 @@
 package helpscout
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// CustomerGenderMale ..
+	CustomerGenderMale = "male"
+
+	// CustomerGenderFemale ..
+	CustomerGenderFemale = "female"
+
+	// CustomerGenderUnknown ..
+	CustomerGenderUnknown = "unknown"
+)
+
+// CustomersLister ..
+type CustomersLister interface {
+	Process(c Customer) bool
+}
+
+// CustomerEmbedded ..
+type CustomerEmbedded struct {
+	Emails         []CustomerEmail         `json:"emails"`
+	Phones         []CustomerPhone         `json:"phones"`
+	Chats          []CustomerChat          `json:"chats"`
+	SocialProfiles []CustomerSocialProfile `json:"social_profiles"`
+	Websites       []CustomerWebsite       `json:"websites"`
+	Address        *CustomerAddress        `json:"address"`
+}
+
 // Customer ..
 type Customer struct {
-	ID        int    `json:"id"`
-	FirstName string `json:"firstName"`
-	LastName  string `json:"lastName"`
+	ID           int              `json:"id"`
+	FirstName    string           `json:"firstName"`
+	LastName     string           `json:"lastName"`
+	Gender       string           `json:"gender"`
+	JobTitle     string           `json:"jobTitle"`
+	Location     string           `json:"location"`
+	Organization string           `json:"organization"`
+	Background   string           `json:"background"`
+	PhotoType    string           `json:"photoType"`
+	PhotoURL     string           `json:"photoUrl"`
+	Age          string           `json:"age"`
+	CreatedAt    time.Time        `json:"createdAt"`
+	UpdatedAt    time.Time        `json:"updatedAt"`
+	Embedded     CustomerEmbedded `json:"_embedded"`
+}
+
+// CustomerFields ..
+type CustomerFields struct {
+	FirstName    string `json:"firstName,omitempty"`
+	LastName     string `json:"lastName,omitempty"`
+	Gender       string `json:"gender,omitempty"`
+	JobTitle     string `json:"jobTitle,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Organization string `json:"organization,omitempty"`
+	Background   string `json:"background,omitempty"`
+	PhotoType    string `json:"photoType,omitempty"`
+	PhotoURL     string `json:"photoUrl,omitempty"`
+	Age          string `json:"age,omitempty"`
+}
+
+// NewCustomer ..
+type NewCustomer struct {
+	CustomerFields
+	Emails         []CustomerEmail         `json:"emails,omitempty"`
+	Phones         []CustomerPhone         `json:"phones,omitempty"`
+	Chats          []CustomerChat          `json:"chats,omitempty"`
+	SocialProfiles []CustomerSocialProfile `json:"socialProfiles,omitempty"`
+	Websites       []CustomerWebsite       `json:"websites,omitempty"`
+	Address        *CustomerAddress        `json:"address,omitempty"`
+}
+
+// CustomerQuery ..
+type CustomerQuery struct {
+	MailboxID     int
+	FirstName     string
+	LastName      string
+	ModifiedSince time.Time
+	SortField     string
+	SortOrder     string
+	Query         SearchQuery
+}
+
+// values ..
+func (q *CustomerQuery) values() *url.Values {
+	query := &url.Values{}
+	if q == nil {
+		return query
+	}
+
+	if q.MailboxID != 0 {
+		query.Set("mailbox", strconv.Itoa(q.MailboxID))
+	}
+
+	if q.FirstName != "" {
+		query.Set("firstName", q.FirstName)
+	}
+
+	if q.LastName != "" {
+		query.Set("lastName", q.LastName)
+	}
+
+	if !q.ModifiedSince.IsZero() {
+		query.Set("modifiedSince", q.ModifiedSince.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+
+	if q.SortField != "" {
+		query.Set("sortField", q.SortField)
+	}
+
+	if q.SortOrder != "" {
+		query.Set("sortOrder", q.SortOrder)
+	}
+
+	if s := searchQueryParam(q.Query); s != "" {
+		query.Set("query", s)
+	}
+
+	return query
+}
+
+// ListCustomers ..
+func (c *Client) ListCustomers(ctx context.Context, query *CustomerQuery, lister CustomersLister) error {
+	customers := c.IterateCustomers(query)
+	for customers.Next(ctx) {
+		if !lister.Process(customers.Value()) {
+			return ErrorInterrupted
+		}
+	}
+
+	return customers.Err()
+}
+
+// CustomerIterator ..
+type CustomerIterator struct {
+	*Pager
+}
+
+// IterateCustomers ..
+func (c *Client) IterateCustomers(query *CustomerQuery) *CustomerIterator {
+	return &CustomerIterator{c.newPager("/customers", "customers", query.values(),
+		func() interface{} { return &Customer{} })}
+}
+
+// Value ..
+func (it *CustomerIterator) Value() Customer {
+	if v, ok := it.Pager.Value().(*Customer); ok {
+		return *v
+	}
+
+	return Customer{}
+}
+
+// All ..
+func (it *CustomerIterator) All(ctx context.Context) ([]Customer, error) {
+	var customers []Customer
+	for it.Next(ctx) {
+		customers = append(customers, it.Value())
+	}
+
+	return customers, it.Err()
+}
+
+// GetCustomer ..
+func (c *Client) GetCustomer(ctx context.Context, id int) (*Customer, error) {
+	var customer Customer
+	if err := c.doAPICall(ctx, http.MethodGet, fmt.Sprintf("/customers/%d", id), nil, nil, &customer); err != nil {
+		return nil, err
+	}
+
+	return &customer, nil
+}
+
+// CreateCustomer ..
+func (c *Client) CreateCustomer(ctx context.Context, customer *NewCustomer) (int, error) {
+	return c.createResource(ctx, "/customers", customer)
+}
+
+// OverwriteCustomer ..
+func (c *Client) OverwriteCustomer(ctx context.Context, id int, fields *CustomerFields) error {
+	return c.doAPICall(ctx, http.MethodPut, fmt.Sprintf("/customers/%d", id), nil, fields, nil)
+}
+
+// PatchCustomer ..
+func (c *Client) PatchCustomer(ctx context.Context, id int, ops ...PatchOperation) error {
+	resource := fmt.Sprintf("/customers/%d", id)
+
+	/* the endpoint accepts a single operation per request */
+	for _, op := range ops {
+		if err := c.doAPICall(ctx, http.MethodPatch, resource, nil, op, nil); err != nil {
+			return errors.Wrapf(err, "Unable to apply %s %s", op.Op, op.Path)
+		}
+	}
+
+	return nil
 }
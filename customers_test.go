@@ -0,0 +1,57 @@
+package helpscout
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPatchCustomerSendsOneOperationPerRequest(t *testing.T) {
+	var mu sync.Mutex
+	var ops []PatchOperation
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		var op PatchOperation
+		if r.Method != http.MethodPatch || r.URL.Path != "/customers/7" || json.Unmarshal(body, &op) != nil {
+			http.Error(w, `{"message":"bad request"}`, http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		ops = append(ops, op)
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := NewClient("id", "key", WithBaseURL(srv.URL))
+	c.SetAuthKey("token", time.Now().Add(time.Hour))
+
+	err := c.PatchCustomer(context.Background(), 7, PatchReplace("/firstName", "Jane"), PatchRemove("/jobTitle"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(ops) != 2 || ops[0].Path != "/firstName" || ops[1].Op != "remove" {
+		t.Fatalf("unexpected operations %+v", ops)
+	}
+}
+
+func TestCustomerQueryEnclosesSearch(t *testing.T) {
+	q := &CustomerQuery{Query: SearchOr(SearchEmail("a@example.com"), SearchEmail("b@example.com"))}
+
+	if got := q.values().Get("query"); got != "(email:a@example.com OR email:b@example.com)" {
+		t.Fatalf("expected a single pair of parentheses, got %s", got)
+	}
+}
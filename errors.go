@@ -0,0 +1,142 @@
+package helpscout
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrorRateLimit ..
+	ErrorRateLimit = errors.New("rate limit exceeded")
+
+	// ErrorUnauthorized ..
+	ErrorUnauthorized = errors.New("unauthorized")
+
+	// ErrorForbidden ..
+	ErrorForbidden = errors.New("forbidden")
+
+	// ErrorNotFound ..
+	ErrorNotFound = errors.New("not found")
+
+	// ErrorValidation ..
+	ErrorValidation = errors.New("validation failed")
+)
+
+// FieldError ..
+type FieldError struct {
+	Path          string      `json:"path"`
+	Message       string      `json:"message"`
+	Source        string      `json:"source"`
+	RejectedValue interface{} `json:"rejectedValue"`
+}
+
+// APIError ..
+type APIError struct {
+	StatusCode int
+	Message    string
+	Errors     []FieldError
+	RequestID  string
+	RetryAfter time.Duration
+}
+
+// Error ..
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("Remote server returned an error: %d", e.StatusCode)
+	if e.Message != "" {
+		msg += " " + e.Message
+	}
+
+	if len(e.Errors) != 0 {
+		fields := make([]string, len(e.Errors))
+		for i, f := range e.Errors {
+			fields[i] = fmt.Sprintf("%s: %s", f.Path, f.Message)
+		}
+
+		msg += fmt.Sprintf(" [%s]", strings.Join(fields, ", "))
+	}
+
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" (request id: %s)", e.RequestID)
+	}
+
+	return msg
+}
+
+// Is ..
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrorRateLimit:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrorUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrorForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrorNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrorValidation:
+		return e.StatusCode == http.StatusBadRequest ||
+			e.StatusCode == http.StatusUnprocessableEntity
+	}
+
+	return false
+}
+
+// newAPIError ..
+func newAPIError(response *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: response.StatusCode,
+		RequestID:  response.Header.Get("Correlation-Id"),
+		RetryAfter: parseRetryAfter(response.Header),
+	}
+
+	if len(body) == 0 {
+		return apiErr
+	}
+
+	var errResp struct {
+		Message  string `json:"message"`
+		LogRef   string `json:"logRef"`
+		Embedded struct {
+			Errors []FieldError `json:"errors"`
+		} `json:"_embedded"`
+	}
+
+	if err := json.Unmarshal(body, &errResp); err != nil {
+		apiErr.Message = strings.TrimSpace(string(body))
+		return apiErr
+	}
+
+	apiErr.Message = errResp.Message
+	apiErr.Errors = errResp.Embedded.Errors
+	if apiErr.RequestID == "" {
+		apiErr.RequestID = errResp.LogRef
+	}
+
+	return apiErr
+}
+
+// parseRetryAfter ..
+func parseRetryAfter(header http.Header) time.Duration {
+	for _, key := range []string{"X-RateLimit-Retry-After", "Retry-After"} {
+		value := header.Get(key)
+		if value == "" {
+			continue
+		}
+
+		if seconds, err := strconv.Atoi(value); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+
+		if t, err := http.ParseTime(value); err == nil {
+			return time.Until(t)
+		}
+	}
+
+	return 0
+}
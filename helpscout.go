@@ -1,8 +1,11 @@
 package helpscout
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -27,79 +30,234 @@ type generalListAPICallReq struct {
 	Page     Page        `json:"page"`
 }
 
+// PatchOperation ..
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// PatchReplace ..
+func PatchReplace(path string, value interface{}) PatchOperation {
+	return PatchOperation{Op: "replace", Path: path, Value: value}
+}
+
+// PatchRemove ..
+func PatchRemove(path string) PatchOperation {
+	return PatchOperation{Op: "remove", Path: path}
+}
+
 // Client ..
 type Client struct {
-	httpClient *httpClient
-	auth       *auth
+	httpClient   *httpClient
+	auth         *auth
+	baseURL      string
+	retryPolicy  RetryPolicy
+	limiter      *rateLimiter
+	authorizeURL string
+	appID        string
+	concurrency  int
 }
 
 // NewClient ..
-func NewClient(appID string, appKey string) *Client {
-	httpClient := newHTTPClient()
+func NewClient(appID string, appKey string, opts ...Option) *Client {
+	o := newOptions(opts)
+	httpClient := newHTTPClient(o)
+
+	auth := newAuth(httpClient, o.retryPolicy, o.authURL, appID, appKey)
+	auth.source = o.tokenSource
+	auth.store = o.tokenStore
 
 	return &Client{
-		httpClient: httpClient,
-		auth:       newAuth(httpClient, appID, appKey),
+		httpClient:   httpClient,
+		auth:         auth,
+		baseURL:      strings.TrimSuffix(o.baseURL, "/"),
+		retryPolicy:  o.retryPolicy,
+		limiter:      newRateLimiter(o.rateLimit),
+		authorizeURL: o.authorizeURL,
+		appID:        appID,
+		concurrency:  o.concurrency,
+	}
+}
+
+// NewClientWithRefreshToken ..
+func NewClientWithRefreshToken(appID string, appKey string, refreshToken string, opts ...Option) *Client {
+	c := NewClient(appID, appKey, opts...)
+	c.auth.refreshToken = refreshToken
+
+	return c
+}
+
+// AuthorizeURL ..
+func (c *Client) AuthorizeURL(state string) string {
+	query := url.Values{}
+	query.Set("client_id", c.appID)
+	if state != "" {
+		query.Set("state", state)
+	}
+
+	return c.authorizeURL + "?" + query.Encode()
+}
+
+// ExchangeCode ..
+func (c *Client) ExchangeCode(ctx context.Context, code string) (*Token, error) {
+	token, err := c.auth.exchangeCode(ctx, code)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to exchange authorization code")
 	}
+
+	return token, nil
+}
+
+// Token ..
+func (c *Client) Token(ctx context.Context) (*Token, error) {
+	token, err := c.auth.Token(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to update Auth Token")
+	}
+
+	return token, nil
 }
 
 // AuthKey ..
 func (c *Client) AuthKey(forceUpdate bool) (string, error) {
-	token, err := c.auth.getToken(forceUpdate)
+	return c.AuthKeyWithContext(context.Background(), forceUpdate)
+}
+
+// AuthKeyWithContext ..
+func (c *Client) AuthKeyWithContext(ctx context.Context, forceUpdate bool) (string, error) {
+	token, err := c.auth.getToken(ctx, forceUpdate)
 	if err != nil {
 		return "", errors.Wrap(err, "Unable to update Auth Token")
 	}
 
-	return token, nil
+	return token.AccessToken, nil
 }
 
 // SetAuthKey ..
-func (c *Client) SetAuthKey(key string, expTime time.Time) {
-	c.auth.token = key
-	c.auth.tokenExpireTime = expTime
+func (c *Client) SetAuthKey(key string, expTime time.Time, refreshToken ...string) {
+	token := &Token{AccessToken: key, Expiry: expTime}
+	if len(refreshToken) > 1 {
+		panic("There must be only one refresh token")
+	}
+
+	if len(refreshToken) == 1 {
+		token.RefreshToken = refreshToken[0]
+	}
+
+	c.auth.setToken(token)
 }
 
 // doAPICall ..
-func (c *Client) doAPICall(method string, resource string, query *url.Values,
+func (c *Client) doAPICall(ctx context.Context, method string, resource string, query *url.Values,
 	reqData interface{}, respData interface{}) error {
 
-	repeatAllCnt := 0
-	forceTokenUpdate := false
+	_, err := c.doAPIRequest(ctx, method, resource, query, reqData, respData)
+	return err
+}
+
+// doAPIRequest ..
+func (c *Client) doAPIRequest(ctx context.Context, method string, resource string, query *url.Values,
+	reqData interface{}, respData interface{}) (*Response, error) {
+
+	url := c.baseURL + resource
+
+	retryCnt := 0
+	authRetryCnt := 0
 	for {
-		token, err := c.auth.getToken(forceTokenUpdate)
+		token, err := c.auth.getToken(ctx, false)
 		if err != nil {
-			return errors.Wrap(err, "Unable to update Auth Token")
+			return nil, errors.Wrap(err, "Unable to update Auth Token")
 		}
 
-		url := helpscoutAPIEndpoint + resource
-
 		authHeader := make(map[string]string)
-		authHeader["Authorization"] = fmt.Sprintf("Bearer %s", token)
-
-		repeatCnt := 0
-		for {
-			err := c.httpClient.doRequest(url, method, authHeader, query, reqData, respData)
-			if err == ErrorRateLimit {
-				time.Sleep(time.Second)
-				repeatCnt++
-				if repeatCnt > 10 {
-					return errors.New("Unable to submit a request (rate-limit)")
-				}
-
-				continue
+		authHeader["Authorization"] = fmt.Sprintf("Bearer %s", token.AccessToken)
+
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := c.httpClient.doRequest(ctx, url, method, authHeader, query, reqData, respData)
+		c.limiter.observe(resp)
+		if err == nil {
+			return resp, nil
+		}
+
+		if errors.Is(err, ErrorUnauthorized) {
+			c.auth.invalidate(token.AccessToken)
+			authRetryCnt++
+			if authRetryCnt > 3 {
+				return resp, errors.Wrap(err, "Unable to submit a request (authorization failed)")
 			}
 
-			if err == ErrorUnauthorized {
-				break
+			continue
+		}
+
+		delay, retry := c.retryPolicy.Backoff(retryCnt, method, err)
+		if !retry {
+			if retryCnt > 0 {
+				return resp, errors.Wrapf(err, "Unable to submit a request (%d retries)", retryCnt)
 			}
 
-			return err
+			return resp, err
 		}
 
-		forceTokenUpdate = true
-		repeatAllCnt++
-		if repeatAllCnt > 3 {
-			return errors.New("Unable to submit a request (authorization failed)")
+		if err := sleepContext(ctx, delay); err != nil {
+			return resp, err
 		}
+		retryCnt++
+	}
+}
+
+// createResource ..
+func (c *Client) createResource(ctx context.Context, resource string, reqData interface{}) (int, error) {
+	resp, err := c.doAPIRequest(ctx, http.MethodPost, resource, nil, reqData, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if resp.ResourceID == 0 {
+		return 0, errors.Errorf("Unable to find the created resource id (Location: %q)", resp.Location)
+	}
+
+	return resp.ResourceID, nil
+}
+
+// Do ..
+func (c *Client) Do(ctx context.Context, method string, resource string, query *url.Values,
+	reqData interface{}, respData interface{}) (*Response, error) {
+
+	return c.doAPIRequest(ctx, method, resource, query, reqData, respData)
+}
+
+// RateLimit ..
+func (c *Client) RateLimit() RateLimit {
+	return c.limiter.lastRateLimit()
+}
+
+// cloneQuery ..
+func cloneQuery(query *url.Values) url.Values {
+	q := url.Values{}
+	if query == nil {
+		return q
+	}
+
+	for k, v := range *query {
+		q[k] = append([]string(nil), v...)
+	}
+
+	return q
+}
+
+// sleepContext ..
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
 	}
 }
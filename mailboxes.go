@@ -1,6 +1,259 @@
 package helpscout
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// MailboxFieldTypeSingleLine ..
+	MailboxFieldTypeSingleLine = "SINGLE_LINE"
+
+	// MailboxFieldTypeMultiLine ..
+	MailboxFieldTypeMultiLine = "MULTI_LINE"
+
+	// MailboxFieldTypeDate ..
+	MailboxFieldTypeDate = "DATE"
+
+	// MailboxFieldTypeNumber ..
+	MailboxFieldTypeNumber = "NUMBER"
+
+	// MailboxFieldTypeDropdown ..
+	MailboxFieldTypeDropdown = "DROPDOWN"
+)
+
+// MailboxesLister ..
+type MailboxesLister interface {
+	Process(m Mailbox) bool
+}
+
+// FoldersLister ..
+type FoldersLister interface {
+	Process(f Folder) bool
+}
+
+// MailboxFieldsLister ..
+type MailboxFieldsLister interface {
+	Process(f MailboxField) bool
+}
+
 // Mailbox ..
 type Mailbox struct {
-	ID int `json:"id"`
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Folder ..
+type Folder struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Type        string    `json:"type"`
+	UserID      int       `json:"userId"`
+	TotalCount  int       `json:"totalCount"`
+	ActiveCount int       `json:"activeCount"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// MailboxFieldOption ..
+type MailboxFieldOption struct {
+	ID    int    `json:"id"`
+	Order int    `json:"order"`
+	Label string `json:"label"`
+}
+
+// MailboxField ..
+type MailboxField struct {
+	ID       int                  `json:"id"`
+	Name     string               `json:"name"`
+	Type     string               `json:"type"`
+	Order    int                  `json:"order"`
+	Required bool                 `json:"required"`
+	Options  []MailboxFieldOption `json:"options"`
+}
+
+// Option ..
+func (f *MailboxField) Option(id int) (MailboxFieldOption, bool) {
+	for _, o := range f.Options {
+		if o.ID == id {
+			return o, true
+		}
+	}
+
+	return MailboxFieldOption{}, false
+}
+
+// Label returns the display value of a conversation field of this
+// definition, which is the option label for dropdown fields
+func (f *MailboxField) Label(field CustomField) string {
+	if f.Type != MailboxFieldTypeDropdown {
+		return field.Value
+	}
+
+	for _, o := range f.Options {
+		if strconv.Itoa(o.ID) == field.Value {
+			return o.Label
+		}
+	}
+
+	return field.Value
+}
+
+// ListMailboxes ..
+func (c *Client) ListMailboxes(ctx context.Context, lister MailboxesLister) error {
+	mailboxes := c.IterateMailboxes()
+	for mailboxes.Next(ctx) {
+		if !lister.Process(mailboxes.Value()) {
+			return ErrorInterrupted
+		}
+	}
+
+	return mailboxes.Err()
+}
+
+// MailboxIterator ..
+type MailboxIterator struct {
+	*Pager
+}
+
+// IterateMailboxes ..
+func (c *Client) IterateMailboxes() *MailboxIterator {
+	return &MailboxIterator{c.newPager("/mailboxes", "mailboxes", nil, func() interface{} { return &Mailbox{} })}
+}
+
+// Value ..
+func (it *MailboxIterator) Value() Mailbox {
+	if v, ok := it.Pager.Value().(*Mailbox); ok {
+		return *v
+	}
+
+	return Mailbox{}
+}
+
+// All ..
+func (it *MailboxIterator) All(ctx context.Context) ([]Mailbox, error) {
+	var mailboxes []Mailbox
+	for it.Next(ctx) {
+		mailboxes = append(mailboxes, it.Value())
+	}
+
+	return mailboxes, it.Err()
+}
+
+// GetMailbox ..
+func (c *Client) GetMailbox(ctx context.Context, id int) (*Mailbox, error) {
+	var mailbox Mailbox
+	if err := c.doAPICall(ctx, http.MethodGet, fmt.Sprintf("/mailboxes/%d", id), nil, nil, &mailbox); err != nil {
+		return nil, err
+	}
+
+	return &mailbox, nil
+}
+
+// FolderIterator ..
+type FolderIterator struct {
+	*Pager
+}
+
+// IterateMailboxFolders ..
+func (c *Client) IterateMailboxFolders(mailboxID int) *FolderIterator {
+	resource := fmt.Sprintf("/mailboxes/%d/folders", mailboxID)
+
+	return &FolderIterator{c.newPager(resource, "folders", nil, func() interface{} { return &Folder{} })}
+}
+
+// Value ..
+func (it *FolderIterator) Value() Folder {
+	if v, ok := it.Pager.Value().(*Folder); ok {
+		return *v
+	}
+
+	return Folder{}
+}
+
+// All ..
+func (it *FolderIterator) All(ctx context.Context) ([]Folder, error) {
+	var folders []Folder
+	for it.Next(ctx) {
+		folders = append(folders, it.Value())
+	}
+
+	return folders, it.Err()
+}
+
+// ListMailboxFolders ..
+func (c *Client) ListMailboxFolders(ctx context.Context, mailboxID int, lister FoldersLister) error {
+	folders := c.IterateMailboxFolders(mailboxID)
+	for folders.Next(ctx) {
+		if !lister.Process(folders.Value()) {
+			return ErrorInterrupted
+		}
+	}
+
+	return folders.Err()
+}
+
+// MailboxFieldIterator ..
+type MailboxFieldIterator struct {
+	*Pager
+}
+
+// IterateMailboxCustomFields ..
+func (c *Client) IterateMailboxCustomFields(mailboxID int) *MailboxFieldIterator {
+	resource := fmt.Sprintf("/mailboxes/%d/fields", mailboxID)
+
+	return &MailboxFieldIterator{c.newPager(resource, "fields", nil, func() interface{} { return &MailboxField{} })}
+}
+
+// Value ..
+func (it *MailboxFieldIterator) Value() MailboxField {
+	if v, ok := it.Pager.Value().(*MailboxField); ok {
+		return *v
+	}
+
+	return MailboxField{}
+}
+
+// All ..
+func (it *MailboxFieldIterator) All(ctx context.Context) ([]MailboxField, error) {
+	var fields []MailboxField
+	for it.Next(ctx) {
+		fields = append(fields, it.Value())
+	}
+
+	return fields, it.Err()
+}
+
+// ListMailboxCustomFields ..
+func (c *Client) ListMailboxCustomFields(ctx context.Context, mailboxID int, lister MailboxFieldsLister) error {
+	fields := c.IterateMailboxCustomFields(mailboxID)
+	for fields.Next(ctx) {
+		if !lister.Process(fields.Value()) {
+			return ErrorInterrupted
+		}
+	}
+
+	return fields.Err()
+}
+
+// ConversationFolder ..
+func (c *Client) ConversationFolder(ctx context.Context, conversation *Conversation) (*Folder, error) {
+	folders := c.IterateMailboxFolders(conversation.MailboxID)
+	for folders.Next(ctx) {
+		if f := folders.Value(); f.ID == conversation.FolderID {
+			return &f, nil
+		}
+	}
+
+	if err := folders.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, ErrorNotFound
 }
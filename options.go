@@ -0,0 +1,164 @@
+package helpscout
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+// Option ..
+type Option func(*options)
+
+type options struct {
+	baseURL      string
+	authURL      string
+	authorizeURL string
+	httpClient   *http.Client
+	transport    http.RoundTripper
+	timeout      time.Duration
+	headers      map[string]string
+	userAgent    string
+	retryPolicy  RetryPolicy
+	rateLimit    int
+	tokenSource  TokenSource
+	tokenStore   TokenStore
+	concurrency  int
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		baseURL:      helpscoutAPIEndpoint,
+		authURL:      helpscoutAuthEndpoint,
+		authorizeURL: helpscoutAuthorizeEndpoint,
+		headers:      make(map[string]string),
+		retryPolicy:  NewDefaultRetryPolicy(),
+		concurrency:  4,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// WithBaseURL ..
+func WithBaseURL(baseURL string) Option {
+	return func(o *options) {
+		o.baseURL = baseURL
+	}
+}
+
+// WithAuthURL ..
+func WithAuthURL(authURL string) Option {
+	return func(o *options) {
+		o.authURL = authURL
+	}
+}
+
+// WithAuthorizeURL ..
+func WithAuthorizeURL(authorizeURL string) Option {
+	return func(o *options) {
+		o.authorizeURL = authorizeURL
+	}
+}
+
+// WithHTTPClient ..
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = client
+	}
+}
+
+// WithTransport ..
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *options) {
+		o.transport = transport
+	}
+}
+
+// WithTimeout ..
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// WithHeader ..
+func WithHeader(key string, value string) Option {
+	return func(o *options) {
+		o.headers[key] = value
+	}
+}
+
+// WithUserAgent ..
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithRetryPolicy ..
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retryPolicy = policy
+	}
+}
+
+// WithRateLimit ..
+func WithRateLimit(perMinute int) Option {
+	return func(o *options) {
+		o.rateLimit = perMinute
+	}
+}
+
+// WithTokenSource ..
+func WithTokenSource(source TokenSource) Option {
+	return func(o *options) {
+		o.tokenSource = source
+	}
+}
+
+// WithTokenStore ..
+func WithTokenStore(store TokenStore) Option {
+	return func(o *options) {
+		o.tokenStore = store
+	}
+}
+
+// WithConcurrency ..
+func WithConcurrency(workers int) Option {
+	return func(o *options) {
+		if workers > 0 {
+			o.concurrency = workers
+		}
+	}
+}
+
+// buildHTTPClient ..
+func (o *options) buildHTTPClient() *http.Client {
+	var client http.Client
+	if o.httpClient != nil {
+		client = *o.httpClient
+	} else {
+		client = http.Client{
+			Timeout: time.Second * 10,
+			Transport: &http.Transport{
+				Dial: (&net.Dialer{
+					Timeout: 5 * time.Second,
+				}).Dial,
+				TLSHandshakeTimeout: 5 * time.Second,
+			},
+		}
+	}
+
+	if o.transport != nil {
+		client.Transport = o.transport
+	}
+
+	if o.timeout > 0 {
+		client.Timeout = o.timeout
+	}
+
+	return &client
+}
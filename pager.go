@@ -0,0 +1,173 @@
+package helpscout
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// pageDecoder splits a raw list response into its items and page details
+type pageDecoder func(body json.RawMessage, key string) ([]json.RawMessage, Page, error)
+
+// Pager ..
+type Pager struct {
+	client     *Client
+	resource   string
+	key        string
+	query      url.Values
+	newItem    func() interface{}
+	decodePage pageDecoder
+
+	items   []interface{}
+	value   interface{}
+	page    Page
+	fetched bool
+	done    bool
+	err     error
+}
+
+// newPager creates a Pager over resource, decoding every item found under
+// _embedded.<key> into the value returned by newItem
+func (c *Client) newPager(resource string, key string, query *url.Values, newItem func() interface{}) *Pager {
+	return &Pager{
+		client:     c,
+		resource:   resource,
+		key:        key,
+		query:      cloneQuery(query),
+		newItem:    newItem,
+		decodePage: decodeHALPage,
+	}
+}
+
+// Next ..
+func (p *Pager) Next(ctx context.Context) bool {
+	for len(p.items) == 0 {
+		if p.done || p.err != nil {
+			p.value = nil
+			return false
+		}
+
+		p.fetch(ctx)
+	}
+
+	p.value = p.items[0]
+	p.items = p.items[1:]
+
+	return true
+}
+
+// Value returns a pointer to the current item
+func (p *Pager) Value() interface{} {
+	return p.value
+}
+
+// Err ..
+func (p *Pager) Err() error {
+	return p.err
+}
+
+// Page ..
+func (p *Pager) Page() Page {
+	return p.page
+}
+
+// All ..
+func (p *Pager) All(ctx context.Context) ([]interface{}, error) {
+	var all []interface{}
+	for p.Next(ctx) {
+		all = append(all, p.Value())
+	}
+
+	return all, p.Err()
+}
+
+// fetch ..
+func (p *Pager) fetch(ctx context.Context) {
+	query := cloneQuery(&p.query)
+	if p.fetched {
+		query.Set("page", strconv.Itoa(p.page.Number+1))
+	}
+
+	var body json.RawMessage
+	if err := p.client.doAPICall(ctx, http.MethodGet, p.resource, &query, nil, &body); err != nil {
+		p.err = err
+		return
+	}
+
+	rawItems, page, err := p.decodePage(body, p.key)
+	if err != nil {
+		p.err = err
+		return
+	}
+
+	items := make([]interface{}, len(rawItems))
+	for i, raw := range rawItems {
+		item := p.newItem()
+		if err := json.Unmarshal(raw, item); err != nil {
+			p.err = errors.Wrapf(err, "Unable to parse %s item as json", p.key)
+			return
+		}
+		items[i] = item
+	}
+
+	/* stop on the last page, or if the server doesn't move forward */
+	if page.Number >= page.TotalPages || (p.fetched && page.Number <= p.page.Number) {
+		p.done = true
+	}
+
+	p.items = items
+	p.page = page
+	p.fetched = true
+}
+
+// decodeHALPage ..
+func decodeHALPage(body json.RawMessage, key string) ([]json.RawMessage, Page, error) {
+	var resp struct {
+		Embedded map[string]json.RawMessage `json:"_embedded"`
+		Page     Page                       `json:"page"`
+	}
+
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, Page{}, errors.Wrap(err, "Unable to parse list response as json")
+	}
+
+	var items []json.RawMessage
+	if raw, ok := resp.Embedded[key]; ok {
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, Page{}, errors.Wrapf(err, "Unable to parse %s list as json", key)
+		}
+	}
+
+	return items, resp.Page, nil
+}
+
+// getEmbedded fetches an unpaginated resource and decodes _embedded.<key> into out
+func (c *Client) getEmbedded(ctx context.Context, resource string, key string, out interface{}) error {
+	var body json.RawMessage
+	if err := c.doAPICall(ctx, http.MethodGet, resource, nil, nil, &body); err != nil {
+		return err
+	}
+
+	var resp struct {
+		Embedded map[string]json.RawMessage `json:"_embedded"`
+	}
+
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return errors.Wrap(err, "Unable to parse list response as json")
+	}
+
+	raw, ok := resp.Embedded[key]
+	if !ok {
+		return nil
+	}
+
+	if err := json.Unmarshal(raw, out); err != nil {
+		return errors.Wrapf(err, "Unable to parse %s list as json", key)
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package helpscout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RatingScore is a customer satisfaction rating. The ratings API sends it
+// as a name, report drilldowns as a number and report filters as a slug,
+// RatingScore decodes and encodes all of them
+type RatingScore int
+
+const (
+	// RatingAll matches every rating when used as a filter
+	RatingAll RatingScore = 0
+
+	// RatingGreat ..
+	RatingGreat RatingScore = 1
+
+	// RatingOkay ..
+	RatingOkay RatingScore = 2
+
+	// RatingNotGood ..
+	RatingNotGood RatingScore = 3
+)
+
+// String ..
+func (r RatingScore) String() string {
+	switch r {
+	case RatingGreat:
+		return "Great"
+	case RatingOkay:
+		return "Okay"
+	case RatingNotGood:
+		return "Not Good"
+	}
+
+	return ""
+}
+
+// filterValue returns the rating parameter of the happiness reports
+func (r RatingScore) filterValue() string {
+	switch r {
+	case RatingGreat:
+		return "great"
+	case RatingOkay:
+		return "ok"
+	case RatingNotGood:
+		return "not-good"
+	}
+
+	return ""
+}
+
+// UnmarshalJSON ..
+func (r *RatingScore) UnmarshalJSON(data []byte) error {
+	var score int
+	if err := json.Unmarshal(data, &score); err == nil {
+		*r = RatingScore(score)
+		return nil
+	}
+
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return errors.Wrap(err, "Unable to parse rating")
+	}
+
+	for _, score := range []RatingScore{RatingGreat, RatingOkay, RatingNotGood} {
+		if strings.EqualFold(name, score.String()) || strings.EqualFold(name, score.filterValue()) {
+			*r = score
+			return nil
+		}
+	}
+
+	return errors.Errorf("Unknown rating %q", name)
+}
+
+// RatingsLister ..
+type RatingsLister interface {
+	Process(r HappinessRating) bool
+}
+
+// Rating ..
+type Rating struct {
+	ID             int         `json:"id"`
+	Rating         RatingScore `json:"rating"`
+	Comments       string      `json:"comments"`
+	ConversationID int         `json:"conversationId"`
+	ThreadID       int         `json:"threadId"`
+	MailboxID      int         `json:"mailboxId"`
+	Customer       Customer    `json:"customer"`
+	User           User        `json:"user"`
+	CreatedAt      time.Time   `json:"createdAt"`
+	ModifiedAt     time.Time   `json:"modifiedAt"`
+}
+
+// GetRating ..
+func (c *Client) GetRating(ctx context.Context, id int) (*Rating, error) {
+	var rating Rating
+	if err := c.doAPICall(ctx, http.MethodGet, fmt.Sprintf("/ratings/%d", id), nil, nil, &rating); err != nil {
+		return nil, err
+	}
+
+	return &rating, nil
+}
+
+// ListRatings ..
+func (c *Client) ListRatings(ctx context.Context, rng ReportRange, filter *ReportFilter, rating RatingScore,
+	lister RatingsLister) error {
+
+	ratings := c.IterateRatings(rng, filter, rating)
+	for ratings.Next(ctx) {
+		if !lister.Process(ratings.Value()) {
+			return ErrorInterrupted
+		}
+	}
+
+	return ratings.Err()
+}
+
+// RatingIterator ..
+type RatingIterator struct {
+	*Pager
+}
+
+// IterateRatings ..
+func (c *Client) IterateRatings(rng ReportRange, filter *ReportFilter, rating RatingScore) *RatingIterator {
+	query, err := reportQuery(rng, filter)
+	if err == nil && rating != RatingAll {
+		query.Set("rating", rating.filterValue())
+	}
+
+	p := c.newPager("/reports/happiness/ratings", "results", query, func() interface{} { return &HappinessRating{} })
+	p.decodePage = decodeReportPage
+	p.err = err
+
+	return &RatingIterator{p}
+}
+
+// Value ..
+func (it *RatingIterator) Value() HappinessRating {
+	if v, ok := it.Pager.Value().(*HappinessRating); ok {
+		return *v
+	}
+
+	return HappinessRating{}
+}
+
+// All ..
+func (it *RatingIterator) All(ctx context.Context) ([]HappinessRating, error) {
+	var ratings []HappinessRating
+	for it.Next(ctx) {
+		ratings = append(ratings, it.Value())
+	}
+
+	return ratings, it.Err()
+}
+
+// decodeReportPage decodes the page/pages/count layout of report drilldowns
+func decodeReportPage(body json.RawMessage, key string) ([]json.RawMessage, Page, error) {
+	var resp map[string]json.RawMessage
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, Page{}, errors.Wrap(err, "Unable to parse report response as json")
+	}
+
+	var page struct {
+		Page  int `json:"page"`
+		Pages int `json:"pages"`
+		Count int `json:"count"`
+	}
+
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, Page{}, errors.Wrap(err, "Unable to parse report page as json")
+	}
+
+	var items []json.RawMessage
+	if raw, ok := resp[key]; ok {
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, Page{}, errors.Wrapf(err, "Unable to parse report %s as json", key)
+		}
+	}
+
+	return items, Page{
+		Size:          len(items),
+		TotalElements: page.Count,
+		TotalPages:    page.Pages,
+		Number:        page.Page,
+	}, nil
+}
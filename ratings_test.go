@@ -0,0 +1,85 @@
+package helpscout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRatingScoreUnmarshal(t *testing.T) {
+	tests := []struct {
+		data string
+		want RatingScore
+	}{
+		{`1`, RatingGreat},
+		{`2`, RatingOkay},
+		{`3`, RatingNotGood},
+		{`"Great"`, RatingGreat},
+		{`"Okay"`, RatingOkay},
+		{`"Not Good"`, RatingNotGood},
+		{`"not-good"`, RatingNotGood},
+	}
+
+	for _, tt := range tests {
+		var score RatingScore
+		if err := json.Unmarshal([]byte(tt.data), &score); err != nil {
+			t.Fatalf("%s: %v", tt.data, err)
+		}
+
+		if score != tt.want {
+			t.Fatalf("%s: expected %v, got %v", tt.data, tt.want, score)
+		}
+	}
+
+	var score RatingScore
+	if err := json.Unmarshal([]byte(`"Awesome"`), &score); err == nil {
+		t.Fatal("expected an error for an unknown rating")
+	}
+}
+
+func TestIterateRatingsDrilldown(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reports/happiness/ratings" || r.URL.Query().Get("rating") != "not-good" {
+			http.Error(w, `{"message":"bad request"}`, http.StatusBadRequest)
+			return
+		}
+
+		page := 1
+		if r.URL.Query().Get("page") == "2" {
+			page = 2
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"page":%d,"pages":2,"count":2,"results":[`+
+			`{"id":%d,"number":100,"threadid":7,"ratingId":3,"ratingComments":"slow",`+
+			`"ratingCreatedAt":"2020-01-02T03:04:05Z","ratingCustomerId":9}]}`, page, page)
+	}))
+	defer srv.Close()
+
+	c := NewClient("id", "key", WithBaseURL(srv.URL))
+	c.SetAuthKey("token", time.Now().Add(time.Hour))
+
+	rng := ReportRange{
+		Start: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	ratings, err := c.IterateRatings(rng, nil, RatingNotGood).All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ratings) != 2 {
+		t.Fatalf("expected 2 ratings, got %d", len(ratings))
+	}
+
+	for i, rating := range ratings {
+		if rating.ConversationID != i+1 || rating.Rating != RatingNotGood || rating.RatingComments != "slow" {
+			t.Fatalf("unexpected rating %+v", rating)
+		}
+	}
+}
@@ -0,0 +1,584 @@
+package helpscout
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ReportViewByDay ..
+	ReportViewByDay = "day"
+
+	// ReportViewByWeek ..
+	ReportViewByWeek = "week"
+
+	// ReportViewByMonth ..
+	ReportViewByMonth = "month"
+)
+
+// ReportRange ..
+type ReportRange struct {
+	Start         time.Time
+	End           time.Time
+	PreviousStart time.Time
+	PreviousEnd   time.Time
+}
+
+// ReportFilter ..
+type ReportFilter struct {
+	Mailboxes []int
+	Tags      []int
+	Types     []string
+	Folders   []int
+}
+
+// reportQuery ..
+func reportQuery(rng ReportRange, filter *ReportFilter) (*url.Values, error) {
+	if rng.Start.IsZero() || rng.End.IsZero() {
+		return nil, errors.Wrap(ErrorValidation, "Report range requires both start and end")
+	}
+
+	if rng.PreviousStart.IsZero() != rng.PreviousEnd.IsZero() {
+		return nil, errors.Wrap(ErrorValidation, "Report previous range requires both start and end")
+	}
+
+	query := &url.Values{}
+
+	start, end := formatFromToTimePeriod(rng.Start, rng.End)
+	query.Set("start", start)
+	query.Set("end", end)
+
+	if !rng.PreviousStart.IsZero() {
+		previousStart, previousEnd := formatFromToTimePeriod(rng.PreviousStart, rng.PreviousEnd)
+		query.Set("previousStart", previousStart)
+		query.Set("previousEnd", previousEnd)
+	}
+
+	if filter != nil {
+		setIntList(query, "mailboxes", filter.Mailboxes)
+		setIntList(query, "tags", filter.Tags)
+		setIntList(query, "folders", filter.Folders)
+		if len(filter.Types) != 0 {
+			query.Set("types", strings.Join(filter.Types, ","))
+		}
+	}
+
+	return query, nil
+}
+
+// setIntList ..
+func setIntList(query *url.Values, key string, values []int) {
+	if len(values) == 0 {
+		return
+	}
+
+	b := make([]string, len(values))
+	for i, v := range values {
+		b[i] = strconv.Itoa(v)
+	}
+
+	query.Set(key, strings.Join(b, ","))
+}
+
+// getReport ..
+func (c *Client) getReport(ctx context.Context, resource string, rng ReportRange, filter *ReportFilter,
+	params url.Values, out interface{}) error {
+
+	query, err := reportQuery(rng, filter)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range params {
+		(*query)[k] = v
+	}
+
+	return c.doAPICall(ctx, http.MethodGet, resource, query, nil, out)
+}
+
+// viewByParams ..
+func viewByParams(viewBy string) url.Values {
+	if viewBy == "" {
+		return nil
+	}
+
+	return url.Values{"viewBy": []string{viewBy}}
+}
+
+// ReportDataPoint is a single entry of a report time series, every numeric
+// field of the entry is collected in Values, keyed by its JSON name
+type ReportDataPoint struct {
+	Date   time.Time
+	Values map[string]float64
+}
+
+// UnmarshalJSON ..
+func (p *ReportDataPoint) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	p.Values = make(map[string]float64, len(raw))
+	for k, v := range raw {
+		if k == "date" {
+			if err := json.Unmarshal(v, &p.Date); err != nil {
+				return errors.Wrap(err, "Unable to parse report date")
+			}
+
+			continue
+		}
+
+		var f float64
+		if err := json.Unmarshal(v, &f); err == nil {
+			p.Values[k] = f
+		}
+	}
+
+	return nil
+}
+
+// ReportTimeSeries ..
+type ReportTimeSeries struct {
+	Current  []ReportDataPoint `json:"current"`
+	Previous []ReportDataPoint `json:"previous"`
+}
+
+// ReportUser ..
+type ReportUser struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ReportConversation ..
+type ReportConversation struct {
+	ID            int       `json:"id"`
+	Number        int       `json:"number"`
+	Type          string    `json:"type"`
+	MailboxID     int       `json:"mailboxid"`
+	Subject       string    `json:"subject"`
+	Status        string    `json:"status"`
+	ThreadCount   int       `json:"threadCount"`
+	Preview       string    `json:"preview"`
+	CustomerID    int       `json:"customerId"`
+	CustomerName  string    `json:"customerName"`
+	CustomerEmail string    `json:"customerEmail"`
+	ModifiedAt    time.Time `json:"modifiedAt"`
+}
+
+// ReportConversationsPage ..
+type ReportConversationsPage struct {
+	Page    int                  `json:"page"`
+	Pages   int                  `json:"pages"`
+	Count   int                  `json:"count"`
+	Results []ReportConversation `json:"results"`
+}
+
+// getDrilldown ..
+func (c *Client) getDrilldown(ctx context.Context, resource string, rng ReportRange, filter *ReportFilter,
+	page int, params url.Values) (*ReportConversationsPage, error) {
+
+	if params == nil {
+		params = url.Values{}
+	}
+
+	if page > 0 {
+		params.Set("page", strconv.Itoa(page))
+	}
+
+	var resp struct {
+		Conversations ReportConversationsPage `json:"conversations"`
+	}
+
+	if err := c.getReport(ctx, resource, rng, filter, params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Conversations, nil
+}
+
+// CompanyReportStats ..
+type CompanyReportStats struct {
+	StartDate          time.Time `json:"startDate"`
+	EndDate            time.Time `json:"endDate"`
+	TotalCustomers     int       `json:"totalCustomers"`
+	TotalConversations int       `json:"totalConversations"`
+	TotalUsers         int       `json:"totalUsers"`
+	CustomersHelped    int       `json:"customersHelped"`
+	Closed             int       `json:"closed"`
+	TotalReplies       int       `json:"totalReplies"`
+	RepliesPerDay      float64   `json:"repliesPerDay"`
+	RepliesToResolve   float64   `json:"repliesToResolve"`
+	HandleTime         float64   `json:"handleTime"`
+	HappinessScore     float64   `json:"happinessScore"`
+	ResponseTime       float64   `json:"responseTime"`
+	ResolutionTime     float64   `json:"resolutionTime"`
+}
+
+// CompanyReportUser ..
+type CompanyReportUser struct {
+	User     ReportUser          `json:"user"`
+	Current  CompanyReportStats  `json:"current"`
+	Previous *CompanyReportStats `json:"previous"`
+}
+
+// CompanyReport ..
+type CompanyReport struct {
+	Current  CompanyReportStats  `json:"current"`
+	Previous *CompanyReportStats `json:"previous"`
+	Deltas   map[string]float64  `json:"deltas"`
+	Users    []CompanyReportUser `json:"users"`
+}
+
+// GetCompanyReport ..
+func (c *Client) GetCompanyReport(ctx context.Context, rng ReportRange, filter *ReportFilter) (*CompanyReport, error) {
+	var report CompanyReport
+	if err := c.getReport(ctx, "/reports/company", rng, filter, nil, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// GetCompanyCustomersHelped ..
+func (c *Client) GetCompanyCustomersHelped(ctx context.Context, rng ReportRange, filter *ReportFilter,
+	viewBy string) (*ReportTimeSeries, error) {
+
+	return c.getTimeSeries(ctx, "/reports/company/customers-helped", rng, filter, viewBy)
+}
+
+// GetCompanyDrilldown ..
+func (c *Client) GetCompanyDrilldown(ctx context.Context, rng ReportRange, filter *ReportFilter,
+	page int) (*ReportConversationsPage, error) {
+
+	return c.getDrilldown(ctx, "/reports/company/drilldown", rng, filter, page, nil)
+}
+
+// BusyTime ..
+type BusyTime struct {
+	Day   int `json:"day"`
+	Hour  int `json:"hour"`
+	Count int `json:"count"`
+}
+
+// ConversationsReportStats ..
+type ConversationsReportStats struct {
+	StartDate           time.Time `json:"startDate"`
+	EndDate             time.Time `json:"endDate"`
+	TotalConversations  int       `json:"totalConversations"`
+	NewConversations    int       `json:"newConversations"`
+	ConversationsPerDay float64   `json:"conversationsPerDay"`
+	Customers           int       `json:"customers"`
+	Conversations       int       `json:"conversations"`
+}
+
+// ConversationsReport ..
+type ConversationsReport struct {
+	BusiestDay BusyTime                  `json:"busiestDay"`
+	Current    ConversationsReportStats  `json:"current"`
+	Previous   *ConversationsReportStats `json:"previous"`
+	Deltas     map[string]float64        `json:"deltas"`
+}
+
+// BusyTimesReport ..
+type BusyTimesReport struct {
+	Current  []BusyTime `json:"current"`
+	Previous []BusyTime `json:"previous"`
+}
+
+// GetConversationsReport ..
+func (c *Client) GetConversationsReport(ctx context.Context, rng ReportRange,
+	filter *ReportFilter) (*ConversationsReport, error) {
+
+	var report ConversationsReport
+	if err := c.getReport(ctx, "/reports/conversations", rng, filter, nil, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// GetConversationsVolumeByChannel ..
+func (c *Client) GetConversationsVolumeByChannel(ctx context.Context, rng ReportRange, filter *ReportFilter,
+	viewBy string) (*ReportTimeSeries, error) {
+
+	return c.getTimeSeries(ctx, "/reports/conversations/volume-by-channel", rng, filter, viewBy)
+}
+
+// GetConversationsBusyTimes ..
+func (c *Client) GetConversationsBusyTimes(ctx context.Context, rng ReportRange,
+	filter *ReportFilter) (*BusyTimesReport, error) {
+
+	var report BusyTimesReport
+	if err := c.getReport(ctx, "/reports/conversations/busy-times", rng, filter, nil, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// GetNewConversations ..
+func (c *Client) GetNewConversations(ctx context.Context, rng ReportRange, filter *ReportFilter,
+	viewBy string) (*ReportTimeSeries, error) {
+
+	return c.getTimeSeries(ctx, "/reports/conversations/new", rng, filter, viewBy)
+}
+
+// GetConversationsDrilldown ..
+func (c *Client) GetConversationsDrilldown(ctx context.Context, rng ReportRange, filter *ReportFilter,
+	page int) (*ReportConversationsPage, error) {
+
+	return c.getDrilldown(ctx, "/reports/conversations/drilldown", rng, filter, page, nil)
+}
+
+// GetNewConversationsDrilldown ..
+func (c *Client) GetNewConversationsDrilldown(ctx context.Context, rng ReportRange, filter *ReportFilter,
+	page int) (*ReportConversationsPage, error) {
+
+	return c.getDrilldown(ctx, "/reports/conversations/new-drilldown", rng, filter, page, nil)
+}
+
+// ProductivityReportStats ..
+type ProductivityReportStats struct {
+	StartDate            time.Time `json:"startDate"`
+	EndDate              time.Time `json:"endDate"`
+	TotalConversations   int       `json:"totalConversations"`
+	ResolutionTime       float64   `json:"resolutionTime"`
+	RepliesToResolve     float64   `json:"repliesToResolve"`
+	ResponseTime         float64   `json:"responseTime"`
+	FirstResponseTime    float64   `json:"firstResponseTime"`
+	Resolved             int       `json:"resolved"`
+	ResolvedOnFirstReply int       `json:"resolvedOnFirstReply"`
+	Closed               int       `json:"closed"`
+	RepliesSent          int       `json:"repliesSent"`
+	HandleTime           float64   `json:"handleTime"`
+}
+
+// ProductivityReport ..
+type ProductivityReport struct {
+	Current  ProductivityReportStats  `json:"current"`
+	Previous *ProductivityReportStats `json:"previous"`
+	Deltas   map[string]float64       `json:"deltas"`
+}
+
+// GetProductivityReport ..
+func (c *Client) GetProductivityReport(ctx context.Context, rng ReportRange,
+	filter *ReportFilter) (*ProductivityReport, error) {
+
+	var report ProductivityReport
+	if err := c.getReport(ctx, "/reports/productivity", rng, filter, nil, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// GetFirstResponseTime ..
+func (c *Client) GetFirstResponseTime(ctx context.Context, rng ReportRange, filter *ReportFilter,
+	viewBy string) (*ReportTimeSeries, error) {
+
+	return c.getTimeSeries(ctx, "/reports/productivity/first-response-time", rng, filter, viewBy)
+}
+
+// GetResolutionTime ..
+func (c *Client) GetResolutionTime(ctx context.Context, rng ReportRange, filter *ReportFilter,
+	viewBy string) (*ReportTimeSeries, error) {
+
+	return c.getTimeSeries(ctx, "/reports/productivity/resolution-time", rng, filter, viewBy)
+}
+
+// GetRepliesSent ..
+func (c *Client) GetRepliesSent(ctx context.Context, rng ReportRange, filter *ReportFilter,
+	viewBy string) (*ReportTimeSeries, error) {
+
+	return c.getTimeSeries(ctx, "/reports/productivity/replies-sent", rng, filter, viewBy)
+}
+
+// HappinessReportStats ..
+type HappinessReportStats struct {
+	StartDate          time.Time `json:"startDate"`
+	EndDate            time.Time `json:"endDate"`
+	HappinessScore     float64   `json:"happinessScore"`
+	GreatCount         int       `json:"greatCount"`
+	OkayCount          int       `json:"okayCount"`
+	NotGoodCount       int       `json:"notGoodCount"`
+	RatingsCount       int       `json:"ratingsCount"`
+	GreatPercent       float64   `json:"ratingsGreatPercent"`
+	OkayPercent        float64   `json:"ratingsOkayPercent"`
+	NotGoodPercent     float64   `json:"ratingsNotGoodPercent"`
+	RatingsPercent     float64   `json:"ratingsPercent"`
+	TotalCustomers     int       `json:"totalCustomers"`
+	TotalConversations int       `json:"totalConversations"`
+}
+
+// HappinessReport ..
+type HappinessReport struct {
+	Current  HappinessReportStats  `json:"current"`
+	Previous *HappinessReportStats `json:"previous"`
+	Deltas   map[string]float64    `json:"deltas"`
+}
+
+// GetHappinessReport ..
+func (c *Client) GetHappinessReport(ctx context.Context, rng ReportRange,
+	filter *ReportFilter) (*HappinessReport, error) {
+
+	var report HappinessReport
+	if err := c.getReport(ctx, "/reports/happiness", rng, filter, nil, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// HappinessRating is a row of the happiness ratings drilldown, Rating is
+// one of RatingGreat, RatingOkay or RatingNotGood
+type HappinessRating struct {
+	ConversationID     int         `json:"id"`
+	Number             int         `json:"number"`
+	Type               string      `json:"type"`
+	ThreadID           int         `json:"threadid"`
+	ThreadCreatedAt    time.Time   `json:"threadCreatedAt"`
+	Rating             RatingScore `json:"ratingId"`
+	RatingComments     string      `json:"ratingComments"`
+	RatingCreatedAt    time.Time   `json:"ratingCreatedAt"`
+	RatingCustomerID   int         `json:"ratingCustomerId"`
+	RatingCustomerName string      `json:"ratingCustomerName"`
+	RatingUserID       int         `json:"ratingUserId"`
+	RatingUserName     string      `json:"ratingUserName"`
+}
+
+// HappinessRatingsPage ..
+type HappinessRatingsPage struct {
+	Page    int               `json:"page"`
+	Pages   int               `json:"pages"`
+	Count   int               `json:"count"`
+	Results []HappinessRating `json:"results"`
+}
+
+// GetHappinessRatings ..
+func (c *Client) GetHappinessRatings(ctx context.Context, rng ReportRange, filter *ReportFilter,
+	rating RatingScore, page int) (*HappinessRatingsPage, error) {
+
+	params := url.Values{}
+	if rating != RatingAll {
+		params.Set("rating", rating.filterValue())
+	}
+
+	if page > 0 {
+		params.Set("page", strconv.Itoa(page))
+	}
+
+	var ratings HappinessRatingsPage
+	if err := c.getReport(ctx, "/reports/happiness/ratings", rng, filter, params, &ratings); err != nil {
+		return nil, err
+	}
+
+	return &ratings, nil
+}
+
+// UserReportStats ..
+type UserReportStats struct {
+	StartDate            time.Time `json:"startDate"`
+	EndDate              time.Time `json:"endDate"`
+	TotalConversations   int       `json:"totalConversations"`
+	ConversationsCreated int       `json:"conversationsCreated"`
+	CustomersHelped      int       `json:"customersHelped"`
+	Closed               int       `json:"closed"`
+	Replies              int       `json:"replies"`
+	RepliesPerDay        float64   `json:"repliesPerDay"`
+	RepliesToResolve     float64   `json:"repliesToResolve"`
+	HandleTime           float64   `json:"handleTime"`
+	HappinessScore       float64   `json:"happinessScore"`
+	ResponseTime         float64   `json:"responseTime"`
+	ResolutionTime       float64   `json:"resolutionTime"`
+	Resolved             int       `json:"resolved"`
+}
+
+// UserReport ..
+type UserReport struct {
+	User     ReportUser         `json:"user"`
+	Current  UserReportStats    `json:"current"`
+	Previous *UserReportStats   `json:"previous"`
+	Deltas   map[string]float64 `json:"deltas"`
+}
+
+// GetUserReport ..
+func (c *Client) GetUserReport(ctx context.Context, userID int, rng ReportRange,
+	filter *ReportFilter) (*UserReport, error) {
+
+	var report UserReport
+	params := url.Values{"user": []string{strconv.Itoa(userID)}}
+	if err := c.getReport(ctx, "/reports/user", rng, filter, params, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// ChannelReportStats ..
+type ChannelReportStats struct {
+	StartDate          time.Time `json:"startDate"`
+	EndDate            time.Time `json:"endDate"`
+	TotalConversations int       `json:"totalConversations"`
+	NewConversations   int       `json:"newConversations"`
+	Customers          int       `json:"customers"`
+	Resolved           int       `json:"resolved"`
+	ResolutionTime     float64   `json:"resolutionTime"`
+	ResponseTime       float64   `json:"responseTime"`
+	FirstResponseTime  float64   `json:"firstResponseTime"`
+	RepliesSent        int       `json:"repliesSent"`
+	HappinessScore     float64   `json:"happinessScore"`
+}
+
+// ChannelReport ..
+type ChannelReport struct {
+	Current  ChannelReportStats  `json:"current"`
+	Previous *ChannelReportStats `json:"previous"`
+	Deltas   map[string]float64  `json:"deltas"`
+}
+
+// GetChatReport ..
+func (c *Client) GetChatReport(ctx context.Context, rng ReportRange, filter *ReportFilter) (*ChannelReport, error) {
+	return c.getChannelReport(ctx, "/reports/chat", rng, filter)
+}
+
+// GetEmailReport ..
+func (c *Client) GetEmailReport(ctx context.Context, rng ReportRange, filter *ReportFilter) (*ChannelReport, error) {
+	return c.getChannelReport(ctx, "/reports/email", rng, filter)
+}
+
+// GetPhoneReport ..
+func (c *Client) GetPhoneReport(ctx context.Context, rng ReportRange, filter *ReportFilter) (*ChannelReport, error) {
+	return c.getChannelReport(ctx, "/reports/phone", rng, filter)
+}
+
+// getChannelReport ..
+func (c *Client) getChannelReport(ctx context.Context, resource string, rng ReportRange,
+	filter *ReportFilter) (*ChannelReport, error) {
+
+	var report ChannelReport
+	if err := c.getReport(ctx, resource, rng, filter, nil, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// getTimeSeries ..
+func (c *Client) getTimeSeries(ctx context.Context, resource string, rng ReportRange, filter *ReportFilter,
+	viewBy string) (*ReportTimeSeries, error) {
+
+	var report ReportTimeSeries
+	if err := c.getReport(ctx, resource, rng, filter, viewByParams(viewBy), &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
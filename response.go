@@ -0,0 +1,55 @@
+package helpscout
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimit ..
+type RateLimit struct {
+	LimitMinute     int
+	RemainingMinute int
+	RetryAfter      time.Duration
+}
+
+// Response ..
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Location   string
+	ResourceID int
+	RateLimit  RateLimit
+}
+
+// newResponse ..
+func newResponse(response *http.Response) *Response {
+	resp := &Response{
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+		Location:   response.Header.Get("Location"),
+		RateLimit: RateLimit{
+			LimitMinute:     -1,
+			RemainingMinute: -1,
+			RetryAfter:      parseRetryAfter(response.Header),
+		},
+	}
+
+	if v, err := strconv.Atoi(response.Header.Get("X-RateLimit-Limit-Minute")); err == nil {
+		resp.RateLimit.LimitMinute = v
+	}
+
+	if v, err := strconv.Atoi(response.Header.Get("X-RateLimit-Remaining-Minute")); err == nil {
+		resp.RateLimit.RemainingMinute = v
+	}
+
+	/* new resources are identified by Resource-ID, or the last segment of Location */
+	if id, err := strconv.Atoi(response.Header.Get("Resource-ID")); err == nil {
+		resp.ResourceID = id
+	} else if id, err := strconv.Atoi(resp.Location[strings.LastIndex(resp.Location, "/")+1:]); err == nil {
+		resp.ResourceID = id
+	}
+
+	return resp
+}
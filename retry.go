@@ -0,0 +1,224 @@
+package helpscout
+
+import (
+	"context"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy ..
+type RetryPolicy interface {
+	// Backoff reports whether a request that failed with err should be
+	// retried and how long to wait before doing so. attempt starts at 0.
+	Backoff(attempt int, method string, err error) (time.Duration, bool)
+}
+
+// DefaultRetryPolicy ..
+type DefaultRetryPolicy struct {
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewDefaultRetryPolicy ..
+func NewDefaultRetryPolicy() *DefaultRetryPolicy {
+	return &DefaultRetryPolicy{
+		MaxRetries: 10,
+		MinBackoff: 500 * time.Millisecond,
+		MaxBackoff: 30 * time.Second,
+	}
+}
+
+// Backoff ..
+func (p *DefaultRetryPolicy) Backoff(attempt int, method string, err error) (time.Duration, bool) {
+	if attempt >= p.MaxRetries || !isRetryableError(method, err) {
+		return 0, false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter, true
+	}
+
+	return p.exponential(attempt), true
+}
+
+// exponential ..
+func (p *DefaultRetryPolicy) exponential(attempt int) time.Duration {
+	backoff := float64(p.MinBackoff) * math.Pow(2, float64(attempt))
+	if backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.rand == nil {
+		p.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	/* "equal jitter": half of the backoff is fixed, the rest is random */
+	half := backoff / 2
+	return time.Duration(half + p.rand.Float64()*half)
+}
+
+// isRetryableError ..
+func isRetryableError(method string, err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, ErrorRateLimit) {
+		return true
+	}
+
+	if !isIdempotentMethod(method) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= 500
+	}
+
+	return isTransientNetError(err)
+}
+
+// isTransientNetError reports whether err is a connection level failure
+// worth retrying. Permanent transport errors like an unknown host, a bad
+// certificate or an unsupported scheme are not
+func isTransientNetError(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, syscall.EPIPE)
+}
+
+// isIdempotentMethod ..
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// rateLimiter is a client-side token bucket. It also pauses all requests
+// once the server reports that the per-minute quota has been used up.
+type rateLimiter struct {
+	mu           sync.Mutex
+	perSecond    float64
+	burst        float64
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+	rateLimit    RateLimit
+}
+
+// newRateLimiter ..
+func newRateLimiter(perMinute int) *rateLimiter {
+	l := &rateLimiter{rateLimit: RateLimit{LimitMinute: -1, RemainingMinute: -1}}
+	if perMinute > 0 {
+		l.perSecond = float64(perMinute) / 60
+		l.burst = math.Max(1, float64(perMinute)/10)
+		l.tokens = l.burst
+		l.last = time.Now()
+	}
+
+	return l
+}
+
+// wait ..
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token and returns zero, or returns how long to wait
+// before trying again
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(l.blockedUntil) {
+		return l.blockedUntil.Sub(now)
+	}
+
+	if l.perSecond == 0 {
+		return 0
+	}
+
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.perSecond)
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.perSecond * float64(time.Second))
+}
+
+// observe ..
+func (l *rateLimiter) observe(resp *Response) {
+	if resp == nil || resp.RateLimit.RemainingMinute < 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rateLimit = resp.RateLimit
+	if resp.RateLimit.RemainingMinute > 0 {
+		return
+	}
+
+	retryAfter := resp.RateLimit.RetryAfter
+	if retryAfter <= 0 {
+		retryAfter = time.Minute - time.Duration(time.Now().Second())*time.Second
+	}
+
+	if until := time.Now().Add(retryAfter); until.After(l.blockedUntil) {
+		l.blockedUntil = until
+	}
+}
+
+// lastRateLimit ..
+func (l *rateLimiter) lastRateLimit() RateLimit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rateLimit
+}
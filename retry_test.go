@@ -0,0 +1,93 @@
+package helpscout
+
+import (
+	"context"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type testTimeoutError struct{}
+
+func (testTimeoutError) Error() string   { return "i/o timeout" }
+func (testTimeoutError) Timeout() bool   { return true }
+func (testTimeoutError) Temporary() bool { return true }
+
+func transportError(err error) error {
+	return errors.Wrap(&url.Error{Op: "Get", URL: "https://api.helpscout.net/v2/conversations", Err: err},
+		"Unable to process request")
+}
+
+func dialError(err error) error {
+	return transportError(&net.OpError{Op: "dial", Net: "tcp", Err: err})
+}
+
+func TestDefaultRetryPolicyBackoff(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		err    error
+		retry  bool
+	}{
+		{"rate limit on GET", http.MethodGet, &APIError{StatusCode: http.StatusTooManyRequests}, true},
+		{"rate limit on POST", http.MethodPost, &APIError{StatusCode: http.StatusTooManyRequests}, true},
+		{"server error on GET", http.MethodGet, &APIError{StatusCode: http.StatusBadGateway}, true},
+		{"server error on POST", http.MethodPost, &APIError{StatusCode: http.StatusBadGateway}, false},
+		{"client error on GET", http.MethodGet, &APIError{StatusCode: http.StatusNotFound}, false},
+		{"timeout on GET", http.MethodGet, transportError(testTimeoutError{}), true},
+		{"timeout on POST", http.MethodPost, transportError(testTimeoutError{}), false},
+		{"connection reset", http.MethodGet, dialError(os.NewSyscallError("read", syscall.ECONNRESET)), true},
+		{"connection refused", http.MethodGet, dialError(os.NewSyscallError("connect", syscall.ECONNREFUSED)), true},
+		{"unexpected EOF", http.MethodGet, transportError(io.ErrUnexpectedEOF), true},
+		{"unknown host", http.MethodGet, dialError(&net.DNSError{Err: "no such host", Name: "api.invalid", IsNotFound: true}), false},
+		{"dns timeout", http.MethodGet, dialError(&net.DNSError{Err: "timeout", Name: "api.helpscout.net", IsTimeout: true}), true},
+		{"bad certificate", http.MethodGet, transportError(x509.UnknownAuthorityError{}), false},
+		{"unsupported scheme", http.MethodGet, transportError(errors.New(`unsupported protocol scheme "ftp"`)), false},
+		{"canceled", http.MethodGet, transportError(context.Canceled), false},
+	}
+
+	p := NewDefaultRetryPolicy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, retry := p.Backoff(0, tt.method, tt.err)
+			if retry != tt.retry {
+				t.Fatalf("expected retry %v, got %v", tt.retry, retry)
+			}
+
+			if retry && (delay < p.MinBackoff/2 || delay > p.MinBackoff) {
+				t.Fatalf("unexpected first backoff %v", delay)
+			}
+		})
+	}
+}
+
+func TestDefaultRetryPolicyRetryAfter(t *testing.T) {
+	p := NewDefaultRetryPolicy()
+
+	err := &APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: 7 * time.Second}
+	delay, retry := p.Backoff(3, http.MethodPost, err)
+	if !retry || delay != 7*time.Second {
+		t.Fatalf("expected a retry after 7s, got %v (%v)", delay, retry)
+	}
+
+	if _, retry := p.Backoff(p.MaxRetries, http.MethodGet, err); retry {
+		t.Fatal("expected no retry once MaxRetries is reached")
+	}
+}
+
+func TestDefaultRetryPolicyMaxBackoff(t *testing.T) {
+	p := NewDefaultRetryPolicy()
+
+	delay, retry := p.Backoff(9, http.MethodGet, &APIError{StatusCode: http.StatusServiceUnavailable})
+	if !retry || delay < p.MaxBackoff/2 || delay > p.MaxBackoff {
+		t.Fatalf("expected a backoff capped at %v, got %v", p.MaxBackoff, delay)
+	}
+}
@@ -0,0 +1,89 @@
+package helpscout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SavedReply ..
+type SavedReply struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Preview string `json:"preview"`
+	Text    string `json:"text"`
+}
+
+// SavedRepliesLister ..
+type SavedRepliesLister interface {
+	Process(r SavedReply) bool
+}
+
+// SavedReplyIterator ..
+type SavedReplyIterator struct {
+	*Pager
+}
+
+// IterateSavedReplies ..
+func (c *Client) IterateSavedReplies(mailboxID int) *SavedReplyIterator {
+	resource := fmt.Sprintf("/mailboxes/%d/saved-replies", mailboxID)
+
+	p := c.newPager(resource, "replies", nil, func() interface{} { return &SavedReply{} })
+	p.decodePage = decodeArrayPage
+
+	return &SavedReplyIterator{p}
+}
+
+// Value ..
+func (it *SavedReplyIterator) Value() SavedReply {
+	if v, ok := it.Pager.Value().(*SavedReply); ok {
+		return *v
+	}
+
+	return SavedReply{}
+}
+
+// All ..
+func (it *SavedReplyIterator) All(ctx context.Context) ([]SavedReply, error) {
+	var replies []SavedReply
+	for it.Next(ctx) {
+		replies = append(replies, it.Value())
+	}
+
+	return replies, it.Err()
+}
+
+// ListSavedReplies ..
+func (c *Client) ListSavedReplies(ctx context.Context, mailboxID int, lister SavedRepliesLister) error {
+	replies := c.IterateSavedReplies(mailboxID)
+	for replies.Next(ctx) {
+		if !lister.Process(replies.Value()) {
+			return ErrorInterrupted
+		}
+	}
+
+	return replies.Err()
+}
+
+// GetSavedReply ..
+func (c *Client) GetSavedReply(ctx context.Context, mailboxID int, id int) (*SavedReply, error) {
+	var reply SavedReply
+	resource := fmt.Sprintf("/mailboxes/%d/saved-replies/%d", mailboxID, id)
+	if err := c.doAPICall(ctx, http.MethodGet, resource, nil, nil, &reply); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+// decodeArrayPage accepts endpoints answering with a plain JSON array,
+// falling back to the _embedded layout of the other list endpoints
+func decodeArrayPage(body json.RawMessage, key string) ([]json.RawMessage, Page, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(body, &items); err == nil {
+		return items, Page{Size: len(items), TotalElements: len(items)}, nil
+	}
+
+	return decodeHALPage(body, key)
+}
@@ -0,0 +1,303 @@
+package helpscout
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SearchQuery ..
+type SearchQuery interface {
+	String() string
+}
+
+// searchTerm is a single, already escaped query term
+type searchTerm string
+
+// String ..
+func (t searchTerm) String() string {
+	return string(t)
+}
+
+// searchGroup ..
+type searchGroup struct {
+	op    string
+	terms []SearchQuery
+}
+
+// String ..
+func (g *searchGroup) String() string {
+	var b []string
+	for _, t := range g.terms {
+		if t == nil {
+			continue
+		}
+
+		if s := t.String(); s != "" {
+			b = append(b, s)
+		}
+	}
+
+	switch len(b) {
+	case 0:
+		return ""
+	case 1:
+		return b[0]
+	default:
+		return fmt.Sprintf("(%s)", strings.Join(b, fmt.Sprintf(" %s ", g.op)))
+	}
+}
+
+// searchNot ..
+type searchNot struct {
+	term SearchQuery
+}
+
+// String ..
+func (n *searchNot) String() string {
+	if n.term == nil {
+		return ""
+	}
+
+	s := n.term.String()
+	if s == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("NOT %s", s)
+}
+
+// SearchAnd ..
+func SearchAnd(terms ...SearchQuery) SearchQuery {
+	return &searchGroup{op: "AND", terms: terms}
+}
+
+// SearchOr ..
+func SearchOr(terms ...SearchQuery) SearchQuery {
+	return &searchGroup{op: "OR", terms: terms}
+}
+
+// SearchNot ..
+func SearchNot(term SearchQuery) SearchQuery {
+	return &searchNot{term: term}
+}
+
+// SearchText ..
+func SearchText(text string) SearchQuery {
+	return searchTerm(quoteSearchValue(text))
+}
+
+// SearchField ..
+func SearchField(name string, value string) SearchQuery {
+	return searchTerm(fmt.Sprintf("%s:%s", name, quoteSearchValue(value)))
+}
+
+// SearchRange ..
+func SearchRange(name string, from string, to string) SearchQuery {
+	if from == "" {
+		from = "*"
+	}
+
+	if to == "" {
+		to = "*"
+	}
+
+	return searchTerm(fmt.Sprintf("%s:[%s TO %s]", name, quoteRangeValue(from), quoteRangeValue(to)))
+}
+
+// SearchIntRange ..
+func SearchIntRange(name string, from int, to int) SearchQuery {
+	return SearchRange(name, strconv.Itoa(from), strconv.Itoa(to))
+}
+
+// SearchTimeRange ..
+func SearchTimeRange(name string, from time.Time, to time.Time) SearchQuery {
+	fromStr, toStr := formatFromToTimePeriod(from, to)
+	return SearchRange(name, fromStr, toStr)
+}
+
+// SearchEmail ..
+func SearchEmail(email string) SearchQuery {
+	return SearchField("email", email)
+}
+
+// SearchCustomerIDs ..
+func SearchCustomerIDs(ids ...int) SearchQuery {
+	return searchIntValues("customerIds", ids)
+}
+
+// SearchMailboxIDs ..
+func SearchMailboxIDs(ids ...int) SearchQuery {
+	return searchIntValues("mailboxid", ids)
+}
+
+// SearchTag ..
+func SearchTag(tag string) SearchQuery {
+	return SearchField("tag", tag)
+}
+
+// SearchSubject ..
+func SearchSubject(subject string) SearchQuery {
+	return SearchField("subject", subject)
+}
+
+// SearchBody ..
+func SearchBody(body string) SearchQuery {
+	return SearchField("body", body)
+}
+
+// SearchAssigned ..
+func SearchAssigned(name string) SearchQuery {
+	return SearchField("assigned", name)
+}
+
+// SearchUnassigned ..
+func SearchUnassigned() SearchQuery {
+	return SearchField("assigned", "unassigned")
+}
+
+// SearchNumber ..
+func SearchNumber(number int) SearchQuery {
+	return SearchField("number", strconv.Itoa(number))
+}
+
+// SearchThreadCount ..
+func SearchThreadCount(from int, to int) SearchQuery {
+	return SearchIntRange("threadCount", from, to)
+}
+
+// SearchStatus ..
+func SearchStatus(statuses ...string) SearchQuery {
+	return searchStringValues("status", statuses)
+}
+
+// SearchType ..
+func SearchType(types ...string) SearchQuery {
+	return searchStringValues("type", types)
+}
+
+// SearchState ..
+func SearchState(states ...string) SearchQuery {
+	return searchStringValues("state", states)
+}
+
+// SearchCreatedAt ..
+func SearchCreatedAt(from time.Time, to time.Time) SearchQuery {
+	return SearchTimeRange("createdAt", from, to)
+}
+
+// SearchModifiedAt ..
+func SearchModifiedAt(from time.Time, to time.Time) SearchQuery {
+	return SearchTimeRange("modifiedAt", from, to)
+}
+
+// searchQueryParam renders q for the query parameter, which Help Scout
+// expects enclosed in parentheses as a whole
+func searchQueryParam(q SearchQuery) string {
+	if q == nil {
+		return ""
+	}
+
+	s := q.String()
+	if s == "" || isEnclosedSearch(s) {
+		return s
+	}
+
+	return fmt.Sprintf("(%s)", s)
+}
+
+// isEnclosedSearch reports whether the parenthesis opening s is the one
+// closing it, quoted text is skipped
+func isEnclosedSearch(s string) bool {
+	if !strings.HasPrefix(s, "(") || !strings.HasSuffix(s, ")") {
+		return false
+	}
+
+	depth := 0
+	quoted := false
+	escaped := false
+	for i, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case quoted:
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+			if depth == 0 {
+				return i == len(s)-1
+			}
+		}
+	}
+
+	return false
+}
+
+// searchStringValues ..
+func searchStringValues(name string, values []string) SearchQuery {
+	terms := make([]SearchQuery, len(values))
+	for i, v := range values {
+		terms[i] = SearchField(name, v)
+	}
+
+	return SearchOr(terms...)
+}
+
+// searchIntValues ..
+func searchIntValues(name string, values []int) SearchQuery {
+	terms := make([]SearchQuery, len(values))
+	for i, v := range values {
+		terms[i] = SearchField(name, strconv.Itoa(v))
+	}
+
+	return SearchOr(terms...)
+}
+
+// quoteSearchValue wraps value in double quotes unless it is a single plain
+// word. A leading - or + would be read as an operator, so it is quoted too
+func quoteSearchValue(value string) string {
+	if isPlainSearchValue(value, "@._+-") && !strings.HasPrefix(value, "-") && !strings.HasPrefix(value, "+") {
+		return value
+	}
+
+	return quoteSearchString(value)
+}
+
+// quoteRangeValue quotes a range bound unless it is a wildcard, a number or
+// a timestamp
+func quoteRangeValue(value string) string {
+	if value == "*" || isPlainSearchValue(value, ".+-:") {
+		return value
+	}
+
+	return quoteSearchString(value)
+}
+
+// isPlainSearchValue ..
+func isPlainSearchValue(value string, extra string) bool {
+	switch value {
+	case "", "AND", "OR", "NOT", "TO":
+		return false
+	}
+
+	for _, r := range value {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' ||
+			strings.ContainsRune(extra, r)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// quoteSearchString ..
+func quoteSearchString(value string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return fmt.Sprintf(`"%s"`, r.Replace(value))
+}
@@ -0,0 +1,84 @@
+package helpscout
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSearchQueryString(t *testing.T) {
+	from := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		query SearchQuery
+		want  string
+	}{
+		{"plain word", SearchText("foo"), `foo`},
+		{"email", SearchEmail("jane.doe+help@example.com"), `email:jane.doe+help@example.com`},
+		{"phrase", SearchText("a b"), `"a b"`},
+		{"leading minus", SearchText("-foo"), `"-foo"`},
+		{"leading plus", SearchText("+foo"), `"+foo"`},
+		{"inner minus", SearchTag("follow-up"), `tag:follow-up`},
+		{"quotes and backslashes", SearchSubject(`say "hi" \o/`), `subject:"say \"hi\" \\o/"`},
+		{"field separator", SearchText("subject:foo"), `"subject:foo"`},
+		{"empty value", SearchField("tag", ""), `tag:""`},
+		{"reserved AND", SearchText("AND"), `"AND"`},
+		{"reserved OR", SearchTag("OR"), `tag:"OR"`},
+		{"reserved NOT", SearchText("NOT"), `"NOT"`},
+		{"reserved TO", SearchText("TO"), `"TO"`},
+		{"lowercase and", SearchText("and"), `and`},
+		{"int range", SearchThreadCount(1, 5), `threadCount:[1 TO 5]`},
+		{"open range", SearchRange("number", "", "10"), `number:[* TO 10]`},
+		{"negative range", SearchIntRange("number", -5, 5), `number:[-5 TO 5]`},
+		{"time range", SearchCreatedAt(from, time.Time{}), `createdAt:[2020-01-02T03:04:05Z TO *]`},
+		{"escaped range", SearchRange("subject", "a] OR [b", "c"), `subject:["a] OR [b" TO c]`},
+		{"or values", SearchStatus("active", "pending"), `(status:active OR status:pending)`},
+		{"single value", SearchStatus("active"), `status:active`},
+		{"not", SearchNot(SearchTag("spam")), `NOT tag:spam`},
+		{
+			"nested",
+			SearchAnd(SearchText("-foo"), SearchText("a b"), SearchOr(SearchTag("x"), SearchNot(SearchMailboxIDs(1, 2)))),
+			`("-foo" AND "a b" AND (tag:x OR NOT (mailboxid:1 OR mailboxid:2)))`,
+		},
+		{"empty and", SearchAnd(), ``},
+		{"empty or", SearchOr(), ``},
+		{"nil terms", SearchAnd(nil, SearchOr(), nil), ``},
+		{"empty not", SearchNot(SearchAnd()), ``},
+		{"nil not", SearchNot(nil), ``},
+		{"collapse single", SearchAnd(SearchOr(), SearchTag("x"), SearchNot(nil)), `tag:x`},
+		{"collapse nested", SearchOr(SearchAnd(SearchAnd(SearchText("foo")))), `foo`},
+		{"no values", SearchStatus(), ``},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.String(); got != tt.want {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSearchQueryParam(t *testing.T) {
+	tests := []struct {
+		name  string
+		query SearchQuery
+		want  string
+	}{
+		{"nil", nil, ``},
+		{"empty", SearchAnd(), ``},
+		{"single term", SearchTag("x"), `(tag:x)`},
+		{"group", SearchAnd(SearchTag("x"), SearchTag("y")), `(tag:x AND tag:y)`},
+		{"not group", SearchNot(SearchOr(SearchTag("x"), SearchTag("y"))), `(NOT (tag:x OR tag:y))`},
+		{"adjacent groups", searchTerm(`(tag:x) OR (tag:y)`), `((tag:x) OR (tag:y))`},
+		{"quoted paren", searchTerm(`("a)" AND b)`), `("a)" AND b)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := searchQueryParam(tt.query); got != tt.want {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -1,11 +1,153 @@
 package helpscout
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// TagsLister ..
+type TagsLister interface {
+	Process(t Tag) bool
+}
+
 // Tag ..
 type Tag struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Color       string    `json:"color"`
+	TicketCount int       `json:"ticketCount"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
 }
 
 // TagShort ..
 type TagShort struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Tag   string `json:"tag"`
+	Color string `json:"color"`
+}
+
+// Label returns the tag name, which conversations report in either field
+func (t TagShort) Label() string {
+	if t.Name != "" {
+		return t.Name
+	}
+
+	return t.Tag
+}
+
+// ListTags ..
+func (c *Client) ListTags(ctx context.Context, lister TagsLister) error {
+	tags := c.IterateTags()
+	for tags.Next(ctx) {
+		if !lister.Process(tags.Value()) {
+			return ErrorInterrupted
+		}
+	}
+
+	return tags.Err()
+}
+
+// TagIterator ..
+type TagIterator struct {
+	*Pager
+}
+
+// IterateTags ..
+func (c *Client) IterateTags() *TagIterator {
+	return &TagIterator{c.newPager("/tags", "tags", nil, func() interface{} { return &Tag{} })}
+}
+
+// Value ..
+func (it *TagIterator) Value() Tag {
+	if v, ok := it.Pager.Value().(*Tag); ok {
+		return *v
+	}
+
+	return Tag{}
+}
+
+// All ..
+func (it *TagIterator) All(ctx context.Context) ([]Tag, error) {
+	var tags []Tag
+	for it.Next(ctx) {
+		tags = append(tags, it.Value())
+	}
+
+	return tags, it.Err()
+}
+
+// SetConversationTags ..
+func (c *Client) SetConversationTags(ctx context.Context, conversationID int, tags []string) error {
+	reqData := struct {
+		Tags []string `json:"tags"`
+	}{
+		Tags: tags,
+	}
+
+	if reqData.Tags == nil {
+		reqData.Tags = []string{}
+	}
+
+	resource := fmt.Sprintf("/conversations/%d/tags", conversationID)
+
+	return c.doAPICall(ctx, http.MethodPut, resource, nil, &reqData, nil)
+}
+
+// AddConversationTags ..
+func (c *Client) AddConversationTags(ctx context.Context, conversationID int, tags ...string) error {
+	return c.updateConversationTags(ctx, conversationID, tags, nil)
+}
+
+// RemoveConversationTags ..
+func (c *Client) RemoveConversationTags(ctx context.Context, conversationID int, tags ...string) error {
+	return c.updateConversationTags(ctx, conversationID, nil, tags)
+}
+
+// updateConversationTags reads the current tags of the conversation and
+// only writes them back if adding and removing changes the set
+func (c *Client) updateConversationTags(ctx context.Context, conversationID int, add []string, remove []string) error {
+	conversation, err := c.GetConversation(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+
+	/* Help Scout tags are case-insensitive */
+	removed := make(map[string]bool, len(remove))
+	for _, t := range remove {
+		removed[strings.ToLower(t)] = true
+	}
+
+	changed := false
+	seen := make(map[string]bool)
+	var tags []string
+	for _, t := range conversation.Tags {
+		name := t.Label()
+		if removed[strings.ToLower(name)] {
+			changed = true
+			continue
+		}
+
+		seen[strings.ToLower(name)] = true
+		tags = append(tags, name)
+	}
+
+	for _, t := range add {
+		if !seen[strings.ToLower(t)] {
+			seen[strings.ToLower(t)] = true
+			tags = append(tags, t)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return c.SetConversationTags(ctx, conversationID, tags)
 }
@@ -0,0 +1,79 @@
+package helpscout
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUpdateConversationTags(t *testing.T) {
+	tests := []struct {
+		name   string
+		add    []string
+		remove []string
+		want   []string
+	}{
+		{"add new", []string{"vip"}, nil, []string{"Billing", "urgent", "vip"}},
+		{"add existing with other case", []string{"billing", "URGENT"}, nil, nil},
+		{"add duplicates", []string{"vip", "VIP", "vip"}, nil, []string{"Billing", "urgent", "vip"}},
+		{"remove with other case", nil, []string{"BILLING"}, []string{"urgent"}},
+		{"remove missing", nil, []string{"vip"}, nil},
+		{"remove all", nil, []string{"billing", "urgent"}, []string{}},
+		{"nothing", nil, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var put []string
+			puts := 0
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/conversations/1":
+					w.Header().Set("Content-Type", "application/hal+json")
+					w.Write([]byte(`{"id":1,"tags":[{"id":1,"tag":"Billing"},{"id":2,"name":"urgent"}]}`))
+				case r.Method == http.MethodPut && r.URL.Path == "/conversations/1/tags":
+					var reqData struct {
+						Tags []string `json:"tags"`
+					}
+
+					body, _ := ioutil.ReadAll(r.Body)
+					if err := json.Unmarshal(body, &reqData); err != nil {
+						http.Error(w, `{"message":"bad request"}`, http.StatusBadRequest)
+						return
+					}
+
+					puts++
+					put = reqData.Tags
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+				}
+			}))
+			defer srv.Close()
+
+			c := NewClient("id", "key", WithBaseURL(srv.URL))
+			c.SetAuthKey("token", time.Now().Add(time.Hour))
+
+			if err := c.updateConversationTags(context.Background(), 1, tt.add, tt.remove); err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.want == nil {
+				if puts != 0 {
+					t.Fatalf("expected no update, got %v", put)
+				}
+				return
+			}
+
+			if puts != 1 || !reflect.DeepEqual(put, tt.want) {
+				t.Fatalf("expected a single update to %v, got %v (%d updates)", tt.want, put, puts)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package helpscout
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TeamsLister ..
+type TeamsLister interface {
+	Process(t Team) bool
+}
+
+// Team ..
+type Team struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Timezone  string    `json:"timezone"`
+	PhotoURL  string    `json:"photoUrl"`
+	Mention   string    `json:"mention"`
+	Initials  string    `json:"initials"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ListTeams ..
+func (c *Client) ListTeams(ctx context.Context, lister TeamsLister) error {
+	teams := c.IterateTeams()
+	for teams.Next(ctx) {
+		if !lister.Process(teams.Value()) {
+			return ErrorInterrupted
+		}
+	}
+
+	return teams.Err()
+}
+
+// TeamIterator ..
+type TeamIterator struct {
+	*Pager
+}
+
+// IterateTeams ..
+func (c *Client) IterateTeams() *TeamIterator {
+	return &TeamIterator{c.newPager("/teams", "teams", nil, func() interface{} { return &Team{} })}
+}
+
+// Value ..
+func (it *TeamIterator) Value() Team {
+	if v, ok := it.Pager.Value().(*Team); ok {
+		return *v
+	}
+
+	return Team{}
+}
+
+// All ..
+func (it *TeamIterator) All(ctx context.Context) ([]Team, error) {
+	var teams []Team
+	for it.Next(ctx) {
+		teams = append(teams, it.Value())
+	}
+
+	return teams, it.Err()
+}
+
+// ListTeamMembers ..
+func (c *Client) ListTeamMembers(ctx context.Context, teamID int, lister UsersLister) error {
+	members := c.IterateTeamMembers(teamID)
+	for members.Next(ctx) {
+		if !lister.Process(members.Value()) {
+			return ErrorInterrupted
+		}
+	}
+
+	return members.Err()
+}
+
+// IterateTeamMembers ..
+func (c *Client) IterateTeamMembers(teamID int) *UserIterator {
+	resource := fmt.Sprintf("/teams/%d/members", teamID)
+
+	return &UserIterator{c.newPager(resource, "users", nil, func() interface{} { return &User{} })}
+}
@@ -1,10 +1,8 @@
 package helpscout
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"net/url"
-	"strconv"
 	"time"
 )
 
@@ -87,62 +85,175 @@ type ThreadSource struct {
 	Type string `json:"type"`
 }
 
+// ThreadEmbedded ..
+type ThreadEmbedded struct {
+	Attachments []Attachment `json:"attachments"`
+}
+
 // Thread ..
 type Thread struct {
-	ID           int           `json:"id"`
-	Type         string        `json:"type"`
-	AssignedTo   User          `json:"assignedTo"`
-	Status       string        `json:"status"`
-	State        string        `json:"state"`
-	Body         string        `json:"body"`
-	Source       ThreadSource  `json:"source"`
-	Customer     Customer      `json:"customer"`
-	CreatedBy    ThreadCreator `json:"createdBy"`
-	SavedReplyID int           `json:"savedReplyId"`
-	To           []string      `json:"to"`
-	CC           []string      `json:"cc"`
-	BCC          []string      `json:"bcc"`
-	CreatedAt    time.Time     `json:"createdAt"`
-	OpenedAt     time.Time     `json:"openedAt"`
+	ID           int            `json:"id"`
+	Type         string         `json:"type"`
+	AssignedTo   User           `json:"assignedTo"`
+	Status       string         `json:"status"`
+	State        string         `json:"state"`
+	Body         string         `json:"body"`
+	Source       ThreadSource   `json:"source"`
+	Customer     Customer       `json:"customer"`
+	CreatedBy    ThreadCreator  `json:"createdBy"`
+	SavedReplyID int            `json:"savedReplyId"`
+	To           []string       `json:"to"`
+	CC           []string       `json:"cc"`
+	BCC          []string       `json:"bcc"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	OpenedAt     time.Time      `json:"openedAt"`
+	Embedded     ThreadEmbedded `json:"_embedded"`
+}
+
+// NewThread ..
+type NewThread struct {
+	Type      string       `json:"type"`
+	Customer  *CustomerRef `json:"customer,omitempty"`
+	Text      string       `json:"text"`
+	User      int          `json:"user,omitempty"`
+	To        []string     `json:"to,omitempty"`
+	CC        []string     `json:"cc,omitempty"`
+	BCC       []string     `json:"bcc,omitempty"`
+	Imported  bool         `json:"imported,omitempty"`
+	CreatedAt *time.Time   `json:"createdAt,omitempty"`
 }
 
 // ListThreads ..
 func (c *Client) ListThreads(conversationID int, lister ThreadLister) error {
-	resource := fmt.Sprintf("/conversations/%d/threads", conversationID)
+	return c.ListThreadsWithContext(context.Background(), conversationID, lister)
+}
 
-	query := &url.Values{}
-	page := 1
-	for {
-		var tList struct {
-			Threads []Thread `json:"threads"`
+// ListThreadsWithContext ..
+func (c *Client) ListThreadsWithContext(ctx context.Context, conversationID int, lister ThreadLister) error {
+	threads := c.IterateThreads(conversationID)
+	for threads.Next(ctx) {
+		if !lister.Process(threads.Value()) {
+			return ErrorInterrupted
 		}
+	}
 
-		req := &generalListAPICallReq{
-			Embedded: &tList,
-		}
+	return threads.Err()
+}
 
-		err := c.doAPICall(http.MethodGet, resource, query, nil, req)
-		if err != nil {
-			return err
-		}
+// ThreadIterator ..
+type ThreadIterator struct {
+	*Pager
+}
 
-		if req.Page.TotalPages == 0 {
-			break
-		}
+// IterateThreads ..
+func (c *Client) IterateThreads(conversationID int) *ThreadIterator {
+	resource := fmt.Sprintf("/conversations/%d/threads", conversationID)
 
-		for _, thread := range tList.Threads {
-			if !lister.Process(thread) {
-				return ErrorInterrupted
-			}
-		}
+	return &ThreadIterator{c.newPager(resource, "threads", nil, func() interface{} { return &Thread{} })}
+}
 
-		if req.Page.Number == req.Page.TotalPages {
-			break
-		}
+// Value ..
+func (it *ThreadIterator) Value() Thread {
+	if v, ok := it.Pager.Value().(*Thread); ok {
+		return *v
+	}
+
+	return Thread{}
+}
 
-		page++
-		query.Set("page", strconv.Itoa(page))
+// All ..
+func (it *ThreadIterator) All(ctx context.Context) ([]Thread, error) {
+	var threads []Thread
+	for it.Next(ctx) {
+		threads = append(threads, it.Value())
 	}
 
-	return nil
+	return threads, it.Err()
+}
+
+// NewAttachment ..
+type NewAttachment struct {
+	FileName string `json:"fileName"`
+	MimeType string `json:"mimeType"`
+	Data     []byte `json:"data"`
+}
+
+// NewReply ..
+type NewReply struct {
+	Customer    CustomerRef     `json:"customer"`
+	Text        string          `json:"text"`
+	Draft       bool            `json:"draft,omitempty"`
+	Status      string          `json:"status,omitempty"`
+	User        int             `json:"user,omitempty"`
+	CC          []string        `json:"cc,omitempty"`
+	BCC         []string        `json:"bcc,omitempty"`
+	Attachments []NewAttachment `json:"attachments,omitempty"`
+	Imported    bool            `json:"imported,omitempty"`
+	CreatedAt   *time.Time      `json:"createdAt,omitempty"`
+}
+
+// NewNote ..
+type NewNote struct {
+	Text        string          `json:"text"`
+	Status      string          `json:"status,omitempty"`
+	User        int             `json:"user,omitempty"`
+	Attachments []NewAttachment `json:"attachments,omitempty"`
+	Imported    bool            `json:"imported,omitempty"`
+	CreatedAt   *time.Time      `json:"createdAt,omitempty"`
+}
+
+// NewCustomerThread ..
+type NewCustomerThread struct {
+	Customer    CustomerRef     `json:"customer"`
+	Text        string          `json:"text"`
+	CC          []string        `json:"cc,omitempty"`
+	BCC         []string        `json:"bcc,omitempty"`
+	Attachments []NewAttachment `json:"attachments,omitempty"`
+	Imported    bool            `json:"imported,omitempty"`
+	CreatedAt   *time.Time      `json:"createdAt,omitempty"`
+}
+
+// NewPhoneThread ..
+type NewPhoneThread struct {
+	Customer    CustomerRef     `json:"customer"`
+	Text        string          `json:"text"`
+	Status      string          `json:"status,omitempty"`
+	User        int             `json:"user,omitempty"`
+	Attachments []NewAttachment `json:"attachments,omitempty"`
+	Imported    bool            `json:"imported,omitempty"`
+	CreatedAt   *time.Time      `json:"createdAt,omitempty"`
+}
+
+// NewChatThread ..
+type NewChatThread struct {
+	Customer    CustomerRef     `json:"customer"`
+	Text        string          `json:"text"`
+	Attachments []NewAttachment `json:"attachments,omitempty"`
+	Imported    bool            `json:"imported,omitempty"`
+	CreatedAt   *time.Time      `json:"createdAt,omitempty"`
+}
+
+// CreateReply ..
+func (c *Client) CreateReply(ctx context.Context, conversationID int, reply *NewReply) (int, error) {
+	return c.createResource(ctx, fmt.Sprintf("/conversations/%d/reply", conversationID), reply)
+}
+
+// CreateNote ..
+func (c *Client) CreateNote(ctx context.Context, conversationID int, note *NewNote) (int, error) {
+	return c.createResource(ctx, fmt.Sprintf("/conversations/%d/notes", conversationID), note)
+}
+
+// CreateCustomerThread ..
+func (c *Client) CreateCustomerThread(ctx context.Context, conversationID int, thread *NewCustomerThread) (int, error) {
+	return c.createResource(ctx, fmt.Sprintf("/conversations/%d/customer", conversationID), thread)
+}
+
+// CreatePhoneThread ..
+func (c *Client) CreatePhoneThread(ctx context.Context, conversationID int, thread *NewPhoneThread) (int, error) {
+	return c.createResource(ctx, fmt.Sprintf("/conversations/%d/phones", conversationID), thread)
+}
+
+// CreateChatThread ..
+func (c *Client) CreateChatThread(ctx context.Context, conversationID int, thread *NewChatThread) (int, error) {
+	return c.createResource(ctx, fmt.Sprintf("/conversations/%d/chats", conversationID), thread)
 }
@@ -0,0 +1,120 @@
+package helpscout
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// TokenStore ..
+type TokenStore interface {
+	// Load returns the stored token, or nil if nothing has been stored yet
+	Load(ctx context.Context) (*Token, error)
+	Save(ctx context.Context, token *Token) error
+}
+
+// MemoryTokenStore ..
+type MemoryTokenStore struct {
+	mu    sync.Mutex
+	token *Token
+}
+
+// NewMemoryTokenStore ..
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{}
+}
+
+// Load ..
+func (s *MemoryTokenStore) Load(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == nil {
+		return nil, nil
+	}
+
+	token := *s.token
+	return &token, nil
+}
+
+// Save ..
+func (s *MemoryTokenStore) Save(ctx context.Context, token *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := *token
+	s.token = &t
+
+	return nil
+}
+
+// FileTokenStore ..
+type FileTokenStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileTokenStore ..
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{path: path}
+}
+
+// Load ..
+func (s *FileTokenStore) Load(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to read token file")
+	}
+
+	var token Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, errors.Wrap(err, "Unable to parse token file as json")
+	}
+
+	return &token, nil
+}
+
+// Save ..
+func (s *FileTokenStore) Save(ctx context.Context, token *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return errors.Wrap(err, "Unable to marshal token")
+	}
+
+	/* write to a temporary file first so readers never see a partial token */
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "Unable to create temporary token file")
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "Unable to write token file")
+	}
+
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "Unable to write token file")
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return errors.Wrap(err, "Unable to replace token file")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package helpscout
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileTokenStoreRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	store := NewFileTokenStore(filepath.Join(dir, "token.json"))
+	ctx := context.Background()
+
+	token, err := store.Load(ctx)
+	if err != nil || token != nil {
+		t.Fatalf("expected no token before the first save, got %+v (%v)", token, err)
+	}
+
+	saved := &Token{
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+		Expiry:       time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := store.Save(ctx, saved); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	loaded, err := NewFileTokenStore(filepath.Join(dir, "token.json")).Load(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if loaded.AccessToken != saved.AccessToken || loaded.RefreshToken != saved.RefreshToken ||
+		!loaded.Expiry.Equal(saved.Expiry) {
+		t.Fatalf("expected %+v, got %+v", saved, loaded)
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 1 {
+		t.Fatalf("expected only the token file to be left, got %d files", len(files))
+	}
+}
+
+func TestFileTokenStoreSaveError(t *testing.T) {
+	store := NewFileTokenStore(filepath.Join(t.TempDir(), "missing", "token.json"))
+
+	if err := store.Save(context.Background(), &Token{AccessToken: "access"}); err == nil {
+		t.Fatal("expected an error saving into a missing directory")
+	}
+}
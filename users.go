@@ -1,9 +1,36 @@
 package helpscout
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
+)
+
+const (
+	// UserRoleOwner ..
+	UserRoleOwner = "owner"
+
+	// UserRoleAdmin ..
+	UserRoleAdmin = "admin"
+
+	// UserRoleUser ..
+	UserRoleUser = "user"
+
+	// UserStatusAvailable ..
+	UserStatusAvailable = "available"
+
+	// UserStatusBusy ..
+	UserStatusBusy = "busy"
+
+	// AssigneeTypeUser ..
+	AssigneeTypeUser = "user"
+
+	// AssigneeTypeTeam ..
+	AssigneeTypeTeam = "team"
 )
 
 // UsersLister ..
@@ -13,47 +40,187 @@ type UsersLister interface {
 
 // User ..
 type User struct {
-	ID        int    `json:"id"`
-	Type      string `json:"type"`
-	FirstName string `json:"first"`
-	LastName  string `json:"last"`
-	Email     string `json:"email"`
+	ID        int       `json:"id"`
+	Type      string    `json:"type"`
+	FirstName string    `json:"first"`
+	LastName  string    `json:"last"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	Timezone  string    `json:"timezone"`
+	PhotoURL  string    `json:"photoUrl"`
+	Mention   string    `json:"mention"`
+	Initials  string    `json:"initials"`
+	JobTitle  string    `json:"jobTitle"`
+	Phone     string    `json:"phone"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// UnmarshalJSON accepts both the short first/last names used on
+// conversations and the firstName/lastName used by the users endpoints
+func (u *User) UnmarshalJSON(data []byte) error {
+	type plain User
+	var aux struct {
+		plain
+		FirstName string `json:"firstName"`
+		LastName  string `json:"lastName"`
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	*u = User(aux.plain)
+	if u.FirstName == "" {
+		u.FirstName = aux.FirstName
+	}
+
+	if u.LastName == "" {
+		u.LastName = aux.LastName
+	}
+
+	return nil
+}
+
+// IsTeam reports whether the user is a team, as conversations can be
+// assigned to teams as well
+func (u User) IsTeam() bool {
+	return u.Type == AssigneeTypeTeam
+}
+
+// UserFilter ..
+type UserFilter struct {
+	MailboxID int
+	Email     string
+}
+
+// values ..
+func (f *UserFilter) values() *url.Values {
+	query := &url.Values{}
+	if f == nil {
+		return query
+	}
+
+	if f.MailboxID != 0 {
+		query.Set("mailbox", strconv.Itoa(f.MailboxID))
+	}
+
+	if f.Email != "" {
+		query.Set("email", f.Email)
+	}
+
+	return query
+}
+
+// UserStatus ..
+type UserStatus struct {
+	UserID int    `json:"id"`
+	Status string `json:"status"`
 }
 
 // ListUsers ..
 func (c *Client) ListUsers(lister UsersLister) error {
-	page := 1
-	query := &url.Values{}
-	for {
-		var uList struct {
-			Users []User `json:"users"`
-		}
+	return c.ListUsersWithContext(context.Background(), lister)
+}
 
-		req := &generalListAPICallReq{
-			Embedded: &uList,
-		}
-		err := c.doAPICall(http.MethodGet, "/users", query, nil, req)
-		if err != nil {
-			return err
-		}
+// ListUsersWithContext ..
+func (c *Client) ListUsersWithContext(ctx context.Context, lister UsersLister) error {
+	return c.ListUsersByFilter(ctx, nil, lister)
+}
 
-		if req.Page.TotalPages == 0 {
-			break
+// ListUsersByFilter ..
+func (c *Client) ListUsersByFilter(ctx context.Context, filter *UserFilter, lister UsersLister) error {
+	users := c.IterateUsers(filter)
+	for users.Next(ctx) {
+		if !lister.Process(users.Value()) {
+			return ErrorInterrupted
 		}
+	}
 
-		for _, user := range uList.Users {
-			if !lister.Process(user) {
-				return ErrorInterrupted
-			}
-		}
+	return users.Err()
+}
 
-		if req.Page.Number == req.Page.TotalPages {
-			break
-		}
+// UserIterator ..
+type UserIterator struct {
+	*Pager
+}
+
+// IterateUsers ..
+func (c *Client) IterateUsers(filter *UserFilter) *UserIterator {
+	return &UserIterator{c.newPager("/users", "users", filter.values(), func() interface{} { return &User{} })}
+}
 
-		page++
-		query.Set("page", strconv.Itoa(page))
+// Value ..
+func (it *UserIterator) Value() User {
+	if v, ok := it.Pager.Value().(*User); ok {
+		return *v
 	}
 
-	return nil
+	return User{}
+}
+
+// All ..
+func (it *UserIterator) All(ctx context.Context) ([]User, error) {
+	var users []User
+	for it.Next(ctx) {
+		users = append(users, it.Value())
+	}
+
+	return users, it.Err()
+}
+
+// GetUser ..
+func (c *Client) GetUser(ctx context.Context, id int) (*User, error) {
+	return c.getUser(ctx, fmt.Sprintf("/users/%d", id))
+}
+
+// GetResourceOwner ..
+func (c *Client) GetResourceOwner(ctx context.Context) (*User, error) {
+	return c.getUser(ctx, "/users/me")
+}
+
+// getUser ..
+func (c *Client) getUser(ctx context.Context, resource string) (*User, error) {
+	var user User
+	if err := c.doAPICall(ctx, http.MethodGet, resource, nil, nil, &user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// ListUserStatuses ..
+func (c *Client) ListUserStatuses(ctx context.Context) ([]UserStatus, error) {
+	var statuses []UserStatus
+	err := c.getEmbedded(ctx, "/users/status", "users", &statuses)
+
+	return statuses, err
+}
+
+// GetUserStatus ..
+func (c *Client) GetUserStatus(ctx context.Context, userID int) (*UserStatus, error) {
+	var status UserStatus
+	resource := fmt.Sprintf("/users/%d/status", userID)
+	if err := c.doAPICall(ctx, http.MethodGet, resource, nil, nil, &status); err != nil {
+		return nil, err
+	}
+
+	if status.UserID == 0 {
+		status.UserID = userID
+	}
+
+	return &status, nil
+}
+
+// SetUserStatus ..
+func (c *Client) SetUserStatus(ctx context.Context, userID int, status string) error {
+	reqData := struct {
+		Status string `json:"status"`
+	}{
+		Status: status,
+	}
+
+	resource := fmt.Sprintf("/users/%d/status", userID)
+
+	return c.doAPICall(ctx, http.MethodPut, resource, nil, &reqData, nil)
 }
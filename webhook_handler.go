@@ -0,0 +1,238 @@
+package helpscout
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// maxWebhookBodySize ..
+const maxWebhookBodySize = 10 << 20
+
+// WebhookEvent ..
+type WebhookEvent struct {
+	Name    string
+	Payload json.RawMessage
+}
+
+// Conversation ..
+func (e *WebhookEvent) Conversation() (*Conversation, error) {
+	var conversation Conversation
+	if err := json.Unmarshal(e.Payload, &conversation); err != nil {
+		return nil, errors.Wrapf(err, "Unable to parse %s payload as conversation", e.Name)
+	}
+
+	return &conversation, nil
+}
+
+// Customer ..
+func (e *WebhookEvent) Customer() (*Customer, error) {
+	var customer Customer
+	if err := json.Unmarshal(e.Payload, &customer); err != nil {
+		return nil, errors.Wrapf(err, "Unable to parse %s payload as customer", e.Name)
+	}
+
+	return &customer, nil
+}
+
+// Tag ..
+func (e *WebhookEvent) Tag() (*Tag, error) {
+	var tag Tag
+	if err := json.Unmarshal(e.Payload, &tag); err != nil {
+		return nil, errors.Wrapf(err, "Unable to parse %s payload as tag", e.Name)
+	}
+
+	return &tag, nil
+}
+
+// Thread returns the thread a convo.*.reply.created or convo.note.created
+// event was sent for, which is the latest thread embedded in the conversation
+func (e *WebhookEvent) Thread() (*Thread, error) {
+	conversation, err := e.Conversation()
+	if err != nil {
+		return nil, err
+	}
+
+	return e.latestThread(conversation)
+}
+
+// latestThread ..
+func (e *WebhookEvent) latestThread(conversation *Conversation) (*Thread, error) {
+	var latest *Thread
+	for i, thread := range conversation.Embedded.Threads {
+		if latest == nil || thread.CreatedAt.After(latest.CreatedAt) ||
+			(thread.CreatedAt.Equal(latest.CreatedAt) && thread.ID > latest.ID) {
+			latest = &conversation.Embedded.Threads[i]
+		}
+	}
+
+	if latest == nil {
+		return nil, errors.Errorf("No thread found in %s payload", e.Name)
+	}
+
+	return latest, nil
+}
+
+// Rating returns the satisfaction rating of a satisfaction.ratings event
+func (e *WebhookEvent) Rating() (*Rating, error) {
+	var rating Rating
+	if err := json.Unmarshal(e.Payload, &rating); err != nil {
+		return nil, errors.Wrapf(err, "Unable to parse %s payload as rating", e.Name)
+	}
+
+	return &rating, nil
+}
+
+// WebhookFunc ..
+type WebhookFunc func(ctx context.Context, event *WebhookEvent) error
+
+// WebhookHandler is an http.Handler receiving Help Scout webhooks. It
+// rejects requests without a valid signature and dispatches every event
+// to the callback registered for its name, unknown events are ignored.
+type WebhookHandler struct {
+	secret []byte
+
+	mu       sync.RWMutex
+	handlers map[string]WebhookFunc
+}
+
+// NewWebhookHandler ..
+func NewWebhookHandler(secret string) *WebhookHandler {
+	return &WebhookHandler{
+		secret:   []byte(secret),
+		handlers: make(map[string]WebhookFunc),
+	}
+}
+
+// Handle ..
+func (h *WebhookHandler) Handle(event string, fn WebhookFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handlers[event] = fn
+}
+
+// HandleConversation ..
+func (h *WebhookHandler) HandleConversation(event string, fn func(ctx context.Context, c *Conversation) error) {
+	h.Handle(event, func(ctx context.Context, e *WebhookEvent) error {
+		conversation, err := e.Conversation()
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, conversation)
+	})
+}
+
+// HandleCustomer ..
+func (h *WebhookHandler) HandleCustomer(event string, fn func(ctx context.Context, c *Customer) error) {
+	h.Handle(event, func(ctx context.Context, e *WebhookEvent) error {
+		customer, err := e.Customer()
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, customer)
+	})
+}
+
+// HandleTag ..
+func (h *WebhookHandler) HandleTag(event string, fn func(ctx context.Context, t *Tag) error) {
+	h.Handle(event, func(ctx context.Context, e *WebhookEvent) error {
+		tag, err := e.Tag()
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, tag)
+	})
+}
+
+// HandleThread ..
+func (h *WebhookHandler) HandleThread(event string, fn func(ctx context.Context, c *Conversation, t *Thread) error) {
+	h.Handle(event, func(ctx context.Context, e *WebhookEvent) error {
+		conversation, err := e.Conversation()
+		if err != nil {
+			return err
+		}
+
+		thread, err := e.latestThread(conversation)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, conversation, thread)
+	})
+}
+
+// HandleRating ..
+func (h *WebhookHandler) HandleRating(fn func(ctx context.Context, r *Rating) error) {
+	h.Handle(EventSatisfactionRatings, func(ctx context.Context, e *WebhookEvent) error {
+		rating, err := e.Rating()
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, rating)
+	})
+}
+
+// VerifySignature ..
+func (h *WebhookHandler) VerifySignature(body []byte, signature string) bool {
+	expected, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha1.New, h.secret)
+	mac.Write(body)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// ServeHTTP ..
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxWebhookBodySize))
+	if err != nil {
+		http.Error(w, "unable to read request body", http.StatusBadRequest)
+		return
+	}
+
+	if !h.VerifySignature(body, r.Header.Get("X-HelpScout-Signature")) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	event := &WebhookEvent{
+		Name:    r.Header.Get("X-HelpScout-Event"),
+		Payload: body,
+	}
+
+	h.mu.RLock()
+	fn, ok := h.handlers[event.Name]
+	h.mu.RUnlock()
+
+	if ok {
+		/* a failed delivery makes Help Scout retry the event later */
+		if err := fn(r.Context(), event); err != nil {
+			http.Error(w, "unable to process event", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,82 @@
+package helpscout
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTestWebhookRequest(secret string, event string, body string) *http.Request {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write([]byte(body))
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.Header.Set("X-HelpScout-Event", event)
+	r.Header.Set("X-HelpScout-Signature", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+
+	return r
+}
+
+func TestWebhookHandlerThread(t *testing.T) {
+	h := NewWebhookHandler("secret")
+
+	var threadID int
+	h.HandleThread(EventConversationNoteCreated, func(ctx context.Context, c *Conversation, thread *Thread) error {
+		threadID = thread.ID
+		return nil
+	})
+
+	body := `{"id":1,"_embedded":{"threads":[` +
+		`{"id":12,"createdAt":"2020-01-02T10:00:00Z"},` +
+		`{"id":11,"createdAt":"2020-01-01T10:00:00Z"}]}}`
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newTestWebhookRequest("secret", EventConversationNoteCreated, body))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+
+	if threadID != 12 {
+		t.Fatalf("expected the latest thread 12, got %d", threadID)
+	}
+}
+
+func TestWebhookHandlerRating(t *testing.T) {
+	h := NewWebhookHandler("secret")
+
+	var rating *Rating
+	h.HandleRating(func(ctx context.Context, r *Rating) error {
+		rating = r
+		return nil
+	})
+
+	body := `{"id":5,"rating":"Great","conversationId":1,"threadId":12}`
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newTestWebhookRequest("secret", EventSatisfactionRatings, body))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+
+	if rating == nil || rating.ID != 5 || rating.Rating != RatingGreat {
+		t.Fatalf("unexpected rating %+v", rating)
+	}
+}
+
+func TestWebhookHandlerInvalidSignature(t *testing.T) {
+	h := NewWebhookHandler("secret")
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newTestWebhookRequest("other", EventSatisfactionRatings, `{}`))
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", w.Code)
+	}
+}
@@ -0,0 +1,152 @@
+package helpscout
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// WebhookPayloadVersion2 ..
+	WebhookPayloadVersion2 = "V2"
+
+	// WebhookStateEnabled ..
+	WebhookStateEnabled = "enabled"
+
+	// WebhookStateDisabled ..
+	WebhookStateDisabled = "disabled"
+
+	// EventConversationAssigned ..
+	EventConversationAssigned = "convo.assigned"
+
+	// EventConversationCreated ..
+	EventConversationCreated = "convo.created"
+
+	// EventConversationDeleted ..
+	EventConversationDeleted = "convo.deleted"
+
+	// EventConversationMerged ..
+	EventConversationMerged = "convo.merged"
+
+	// EventConversationMoved ..
+	EventConversationMoved = "convo.moved"
+
+	// EventConversationStatus ..
+	EventConversationStatus = "convo.status"
+
+	// EventConversationTags ..
+	EventConversationTags = "convo.tags"
+
+	// EventConversationCustomFields ..
+	EventConversationCustomFields = "convo.custom-fields"
+
+	// EventConversationAgentReplyCreated ..
+	EventConversationAgentReplyCreated = "convo.agent.reply.created"
+
+	// EventConversationCustomerReplyCreated ..
+	EventConversationCustomerReplyCreated = "convo.customer.reply.created"
+
+	// EventConversationNoteCreated ..
+	EventConversationNoteCreated = "convo.note.created"
+
+	// EventCustomerCreated ..
+	EventCustomerCreated = "customer.created"
+
+	// EventCustomerUpdated ..
+	EventCustomerUpdated = "customer.updated"
+
+	// EventSatisfactionRatings ..
+	EventSatisfactionRatings = "satisfaction.ratings"
+
+	// EventTagCreated ..
+	EventTagCreated = "tag.created"
+
+	// EventTagUpdated ..
+	EventTagUpdated = "tag.updated"
+
+	// EventTagDeleted ..
+	EventTagDeleted = "tag.deleted"
+)
+
+// WebhooksLister ..
+type WebhooksLister interface {
+	Process(w Webhook) bool
+}
+
+// Webhook ..
+type Webhook struct {
+	ID             int      `json:"id,omitempty"`
+	URL            string   `json:"url"`
+	State          string   `json:"state,omitempty"`
+	Events         []string `json:"events"`
+	Secret         string   `json:"secret,omitempty"`
+	PayloadVersion string   `json:"payloadVersion,omitempty"`
+	Label          string   `json:"label,omitempty"`
+	Notification   bool     `json:"notification"`
+}
+
+// ListWebhooks ..
+func (c *Client) ListWebhooks(ctx context.Context, lister WebhooksLister) error {
+	webhooks := c.IterateWebhooks()
+	for webhooks.Next(ctx) {
+		if !lister.Process(webhooks.Value()) {
+			return ErrorInterrupted
+		}
+	}
+
+	return webhooks.Err()
+}
+
+// WebhookIterator ..
+type WebhookIterator struct {
+	*Pager
+}
+
+// IterateWebhooks ..
+func (c *Client) IterateWebhooks() *WebhookIterator {
+	return &WebhookIterator{c.newPager("/webhooks", "webhooks", nil, func() interface{} { return &Webhook{} })}
+}
+
+// Value ..
+func (it *WebhookIterator) Value() Webhook {
+	if v, ok := it.Pager.Value().(*Webhook); ok {
+		return *v
+	}
+
+	return Webhook{}
+}
+
+// All ..
+func (it *WebhookIterator) All(ctx context.Context) ([]Webhook, error) {
+	var webhooks []Webhook
+	for it.Next(ctx) {
+		webhooks = append(webhooks, it.Value())
+	}
+
+	return webhooks, it.Err()
+}
+
+// GetWebhook ..
+func (c *Client) GetWebhook(ctx context.Context, id int) (*Webhook, error) {
+	var webhook Webhook
+	if err := c.doAPICall(ctx, http.MethodGet, fmt.Sprintf("/webhooks/%d", id), nil, nil, &webhook); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// CreateWebhook ..
+func (c *Client) CreateWebhook(ctx context.Context, webhook *Webhook) (int, error) {
+	return c.createResource(ctx, "/webhooks", webhook)
+}
+
+// UpdateWebhook ..
+func (c *Client) UpdateWebhook(ctx context.Context, webhook *Webhook) error {
+	return c.doAPICall(ctx, http.MethodPut, fmt.Sprintf("/webhooks/%d", webhook.ID), nil, webhook, nil)
+}
+
+// DeleteWebhook ..
+func (c *Client) DeleteWebhook(ctx context.Context, id int) error {
+	return c.doAPICall(ctx, http.MethodDelete, fmt.Sprintf("/webhooks/%d", id), nil, nil, nil)
+}
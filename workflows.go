@@ -0,0 +1,118 @@
+package helpscout
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// WorkflowTypeAutomatic ..
+	WorkflowTypeAutomatic = "automatic"
+
+	// WorkflowTypeManual ..
+	WorkflowTypeManual = "manual"
+
+	// WorkflowStatusActive ..
+	WorkflowStatusActive = "active"
+
+	// WorkflowStatusInactive ..
+	WorkflowStatusInactive = "inactive"
+
+	// WorkflowStatusInvalid ..
+	WorkflowStatusInvalid = "invalid"
+)
+
+// WorkflowsLister ..
+type WorkflowsLister interface {
+	Process(w Workflow) bool
+}
+
+// Workflow ..
+type Workflow struct {
+	ID         int       `json:"id"`
+	MailboxID  int       `json:"mailboxId"`
+	Type       string    `json:"type"`
+	Status     string    `json:"status"`
+	Order      int       `json:"order"`
+	Name       string    `json:"name"`
+	CreatedAt  time.Time `json:"createdAt"`
+	ModifiedAt time.Time `json:"modifiedAt"`
+}
+
+// ListWorkflows ..
+func (c *Client) ListWorkflows(ctx context.Context, mailboxID int, lister WorkflowsLister) error {
+	workflows := c.IterateWorkflows(mailboxID)
+	for workflows.Next(ctx) {
+		if !lister.Process(workflows.Value()) {
+			return ErrorInterrupted
+		}
+	}
+
+	return workflows.Err()
+}
+
+// WorkflowIterator ..
+type WorkflowIterator struct {
+	*Pager
+}
+
+// IterateWorkflows lists the workflows of a mailbox, or of all mailboxes if mailboxID is 0
+func (c *Client) IterateWorkflows(mailboxID int) *WorkflowIterator {
+	query := &url.Values{}
+	if mailboxID != 0 {
+		query.Set("mailboxId", strconv.Itoa(mailboxID))
+	}
+
+	return &WorkflowIterator{c.newPager("/workflows", "workflows", query, func() interface{} { return &Workflow{} })}
+}
+
+// Value ..
+func (it *WorkflowIterator) Value() Workflow {
+	if v, ok := it.Pager.Value().(*Workflow); ok {
+		return *v
+	}
+
+	return Workflow{}
+}
+
+// All ..
+func (it *WorkflowIterator) All(ctx context.Context) ([]Workflow, error) {
+	var workflows []Workflow
+	for it.Next(ctx) {
+		workflows = append(workflows, it.Value())
+	}
+
+	return workflows, it.Err()
+}
+
+// ActivateWorkflow ..
+func (c *Client) ActivateWorkflow(ctx context.Context, id int) error {
+	return c.setWorkflowStatus(ctx, id, WorkflowStatusActive)
+}
+
+// DeactivateWorkflow ..
+func (c *Client) DeactivateWorkflow(ctx context.Context, id int) error {
+	return c.setWorkflowStatus(ctx, id, WorkflowStatusInactive)
+}
+
+// setWorkflowStatus ..
+func (c *Client) setWorkflowStatus(ctx context.Context, id int, status string) error {
+	op := PatchReplace("/status", status)
+
+	return c.doAPICall(ctx, http.MethodPatch, fmt.Sprintf("/workflows/%d", id), nil, &op, nil)
+}
+
+// RunWorkflow runs a manual workflow on the given conversations
+func (c *Client) RunWorkflow(ctx context.Context, id int, conversationIDs []int) error {
+	reqData := struct {
+		ConversationIDs []int `json:"conversationIds"`
+	}{
+		ConversationIDs: conversationIDs,
+	}
+
+	return c.doAPICall(ctx, http.MethodPost, fmt.Sprintf("/workflows/%d/run", id), nil, &reqData, nil)
+}